@@ -14,19 +14,29 @@ import (
 var (
 	packageName = flag.String("package-name", "turboql", "Go package name of the generated files")
 	pgSchema    = flag.String("pg-schema", "public", "The schema name of postgres tables")
+	configFile  = flag.String("config", "", "The JSON file overriding types of columns")
 )
 
 func main() {
 	flag.Parse()
 
-	cfg, err := pgx.ParseConfig(os.Getenv("PG_URI"))
+	var cfg pgschema.Config
+	if *configFile != "" {
+		var err error
+		cfg, err = pgschema.LoadConfig(*configFile)
+		if err != nil {
+			log.Fatalf("Could not load the config: %v", err)
+		}
+	}
+
+	pgCfg, err := pgx.ParseConfig(os.Getenv("PG_URI"))
 	if err != nil {
 		log.Fatalf("Could not parse PG_URI env var: %v", err)
 	}
 
 	ctx := context.Background()
 
-	db, err := pgx.ConnectConfig(ctx, cfg)
+	db, err := pgx.ConnectConfig(ctx, pgCfg)
 	if err != nil {
 		log.Fatalf("Could not connect to the database: %v, check your PG_URI environment variable", err)
 	}
@@ -36,6 +46,16 @@ func main() {
 		log.Fatalf("Could not scan the schema %q: %v", *pgSchema, err)
 	}
 
+	enums, err := pgschema.ScanEnums(ctx, db)
+	if err != nil {
+		log.Fatalf("Could not scan enums: %v", err)
+	}
+
+	schema, err := pgschema.NewSchema(tables, enums, cfg)
+	if err != nil {
+		log.Fatalf("Could not resolve the schema %q: %v", *pgSchema, err)
+	}
+
 	b, err := pgschema.NewBuilder(os.Stdout)
 	if err != nil {
 		log.Fatalf("Could not create the schema builder: %v", err)
@@ -45,7 +65,7 @@ func main() {
 		Package: pgschema.Package{
 			Name: *packageName,
 		},
-		Schema: pgschema.NewSchema(tables),
+		Schema: schema,
 	}); err != nil {
 		log.Fatalf("Could not create the schema file: %v", err)
 	}
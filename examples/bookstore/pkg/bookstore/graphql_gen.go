@@ -14,84 +14,294 @@ import (
 )
 
 type Address struct {
-	AddressId    int
-	StreetNumber string
-	StreetName   string
-	City         string
-	CountryId    int
+	AddressId    int     `db:"address_id"`
+	StreetNumber *string `db:"street_number"`
+	StreetName   *string `db:"street_name"`
+	City         *string `db:"city"`
+	CountryId    *int    `db:"country_id"`
 }
 type AddressStatus struct {
-	StatusId      int
-	AddressStatus string
+	StatusId      int     `db:"status_id"`
+	AddressStatus *string `db:"address_status"`
 }
 type Author struct {
-	AuthorId   int
-	AuthorName string
+	AuthorId   int     `db:"author_id"`
+	AuthorName *string `db:"author_name"`
 }
 type Book struct {
-	BookId          int
-	Title           string
-	Isbn13          string
-	LanguageId      int
-	NumPages        int
-	PublicationDate time.Time
-	PublisherId     int
+	BookId          int        `db:"book_id"`
+	Title           *string    `db:"title"`
+	Isbn13          *string    `db:"isbn13"`
+	LanguageId      *int       `db:"language_id"`
+	NumPages        *int       `db:"num_pages"`
+	PublicationDate *time.Time `db:"publication_date"`
+	PublisherId     *int       `db:"publisher_id"`
 }
 type BookAuthor struct {
-	BookId   int
-	AuthorId int
+	BookId   int `db:"book_id"`
+	AuthorId int `db:"author_id"`
 }
 type BookLanguage struct {
-	LanguageId   int
-	LanguageCode string
-	LanguageName string
+	LanguageId   int     `db:"language_id"`
+	LanguageCode *string `db:"language_code"`
+	LanguageName *string `db:"language_name"`
 }
 type Country struct {
-	CountryId   int
-	CountryName string
+	CountryId   int     `db:"country_id"`
+	CountryName *string `db:"country_name"`
 }
 type CustOrder struct {
-	OrderId          int
-	OrderDate        time.Time
-	CustomerId       int
-	ShippingMethodId int
-	DestAddressId    int
+	OrderId          int        `db:"order_id"`
+	OrderDate        *time.Time `db:"order_date"`
+	CustomerId       *int       `db:"customer_id"`
+	ShippingMethodId *int       `db:"shipping_method_id"`
+	DestAddressId    *int       `db:"dest_address_id"`
 }
 type Customer struct {
-	CustomerId int
-	FirstName  string
-	LastName   string
-	Email      string
+	CustomerId int     `db:"customer_id"`
+	FirstName  *string `db:"first_name"`
+	LastName   *string `db:"last_name"`
+	Email      *string `db:"email"`
 }
 type CustomerAddress struct {
-	CustomerId int
-	AddressId  int
-	StatusId   int
+	CustomerId int  `db:"customer_id"`
+	AddressId  int  `db:"address_id"`
+	StatusId   *int `db:"status_id"`
 }
 type OrderHistory struct {
-	HistoryId  int
-	OrderId    int
-	StatusId   int
-	StatusDate time.Time
+	HistoryId  int        `db:"history_id"`
+	OrderId    *int       `db:"order_id"`
+	StatusId   *int       `db:"status_id"`
+	StatusDate *time.Time `db:"status_date"`
 }
 type OrderLine struct {
-	LineId  int
-	OrderId int
-	BookId  int
-	Price   pgtype.Numeric
+	LineId  int            `db:"line_id"`
+	OrderId *int           `db:"order_id"`
+	BookId  *int           `db:"book_id"`
+	Price   pgtype.Numeric `db:"price"`
 }
 type OrderStatus struct {
-	StatusId    int
-	StatusValue string
+	StatusId    int     `db:"status_id"`
+	StatusValue *string `db:"status_value"`
 }
 type Publisher struct {
-	PublisherId   int
-	PublisherName string
+	PublisherId   int     `db:"publisher_id"`
+	PublisherName *string `db:"publisher_name"`
 }
 type ShippingMethod struct {
-	MethodId   int
-	MethodName string
-	Cost       pgtype.Numeric
+	MethodId   int            `db:"method_id"`
+	MethodName *string        `db:"method_name"`
+	Cost       pgtype.Numeric `db:"cost"`
+}
+type AddressAggregate struct {
+	Count int64                  `db:"count"`
+	Min   AddressAggregateMinMax `db:"min"`
+	Max   AddressAggregateMinMax `db:"max"`
+}
+
+type AddressAggregateMinMax struct {
+	AddressId    *int    `db:"address_id"`
+	StreetNumber *string `db:"street_number"`
+	StreetName   *string `db:"street_name"`
+	City         *string `db:"city"`
+	CountryId    *int    `db:"country_id"`
+}
+type AddressStatusAggregate struct {
+	Count int64                        `db:"count"`
+	Min   AddressStatusAggregateMinMax `db:"min"`
+	Max   AddressStatusAggregateMinMax `db:"max"`
+}
+
+type AddressStatusAggregateMinMax struct {
+	StatusId      *int    `db:"status_id"`
+	AddressStatus *string `db:"address_status"`
+}
+type AuthorAggregate struct {
+	Count int64                 `db:"count"`
+	Min   AuthorAggregateMinMax `db:"min"`
+	Max   AuthorAggregateMinMax `db:"max"`
+}
+
+type AuthorAggregateMinMax struct {
+	AuthorId   *int    `db:"author_id"`
+	AuthorName *string `db:"author_name"`
+}
+type BookAggregate struct {
+	Count int64               `db:"count"`
+	Sum   BookAggregateSum    `db:"sum"`
+	Avg   BookAggregateAvg    `db:"avg"`
+	Min   BookAggregateMinMax `db:"min"`
+	Max   BookAggregateMinMax `db:"max"`
+}
+
+type BookAggregateSum struct {
+	NumPages *int64 `db:"num_pages"`
+}
+
+type BookAggregateAvg struct {
+	NumPages pgtype.Numeric `db:"num_pages"`
+}
+
+type BookAggregateMinMax struct {
+	BookId          *int       `db:"book_id"`
+	Title           *string    `db:"title"`
+	Isbn13          *string    `db:"isbn13"`
+	LanguageId      *int       `db:"language_id"`
+	NumPages        *int       `db:"num_pages"`
+	PublicationDate *time.Time `db:"publication_date"`
+	PublisherId     *int       `db:"publisher_id"`
+}
+type BookAuthorAggregate struct {
+	Count int64                     `db:"count"`
+	Min   BookAuthorAggregateMinMax `db:"min"`
+	Max   BookAuthorAggregateMinMax `db:"max"`
+}
+
+type BookAuthorAggregateMinMax struct {
+	BookId   *int `db:"book_id"`
+	AuthorId *int `db:"author_id"`
+}
+type BookLanguageAggregate struct {
+	Count int64                       `db:"count"`
+	Min   BookLanguageAggregateMinMax `db:"min"`
+	Max   BookLanguageAggregateMinMax `db:"max"`
+}
+
+type BookLanguageAggregateMinMax struct {
+	LanguageId   *int    `db:"language_id"`
+	LanguageCode *string `db:"language_code"`
+	LanguageName *string `db:"language_name"`
+}
+type CountryAggregate struct {
+	Count int64                  `db:"count"`
+	Min   CountryAggregateMinMax `db:"min"`
+	Max   CountryAggregateMinMax `db:"max"`
+}
+
+type CountryAggregateMinMax struct {
+	CountryId   *int    `db:"country_id"`
+	CountryName *string `db:"country_name"`
+}
+type CustOrderAggregate struct {
+	Count int64                    `db:"count"`
+	Min   CustOrderAggregateMinMax `db:"min"`
+	Max   CustOrderAggregateMinMax `db:"max"`
+}
+
+type CustOrderAggregateMinMax struct {
+	OrderId          *int       `db:"order_id"`
+	OrderDate        *time.Time `db:"order_date"`
+	CustomerId       *int       `db:"customer_id"`
+	ShippingMethodId *int       `db:"shipping_method_id"`
+	DestAddressId    *int       `db:"dest_address_id"`
+}
+type CustomerAggregate struct {
+	Count int64                   `db:"count"`
+	Min   CustomerAggregateMinMax `db:"min"`
+	Max   CustomerAggregateMinMax `db:"max"`
+}
+
+type CustomerAggregateMinMax struct {
+	CustomerId *int    `db:"customer_id"`
+	FirstName  *string `db:"first_name"`
+	LastName   *string `db:"last_name"`
+	Email      *string `db:"email"`
+}
+type CustomerAddressAggregate struct {
+	Count int64                          `db:"count"`
+	Sum   CustomerAddressAggregateSum    `db:"sum"`
+	Avg   CustomerAddressAggregateAvg    `db:"avg"`
+	Min   CustomerAddressAggregateMinMax `db:"min"`
+	Max   CustomerAddressAggregateMinMax `db:"max"`
+}
+
+type CustomerAddressAggregateSum struct {
+	StatusId *int64 `db:"status_id"`
+}
+
+type CustomerAddressAggregateAvg struct {
+	StatusId pgtype.Numeric `db:"status_id"`
+}
+
+type CustomerAddressAggregateMinMax struct {
+	CustomerId *int `db:"customer_id"`
+	AddressId  *int `db:"address_id"`
+	StatusId   *int `db:"status_id"`
+}
+type OrderHistoryAggregate struct {
+	Count int64                       `db:"count"`
+	Min   OrderHistoryAggregateMinMax `db:"min"`
+	Max   OrderHistoryAggregateMinMax `db:"max"`
+}
+
+type OrderHistoryAggregateMinMax struct {
+	HistoryId  *int       `db:"history_id"`
+	OrderId    *int       `db:"order_id"`
+	StatusId   *int       `db:"status_id"`
+	StatusDate *time.Time `db:"status_date"`
+}
+type OrderLineAggregate struct {
+	Count int64                    `db:"count"`
+	Sum   OrderLineAggregateSum    `db:"sum"`
+	Avg   OrderLineAggregateAvg    `db:"avg"`
+	Min   OrderLineAggregateMinMax `db:"min"`
+	Max   OrderLineAggregateMinMax `db:"max"`
+}
+
+type OrderLineAggregateSum struct {
+	Price pgtype.Numeric `db:"price"`
+}
+
+type OrderLineAggregateAvg struct {
+	Price pgtype.Numeric `db:"price"`
+}
+
+type OrderLineAggregateMinMax struct {
+	LineId  *int           `db:"line_id"`
+	OrderId *int           `db:"order_id"`
+	BookId  *int           `db:"book_id"`
+	Price   pgtype.Numeric `db:"price"`
+}
+type OrderStatusAggregate struct {
+	Count int64                      `db:"count"`
+	Min   OrderStatusAggregateMinMax `db:"min"`
+	Max   OrderStatusAggregateMinMax `db:"max"`
+}
+
+type OrderStatusAggregateMinMax struct {
+	StatusId    *int    `db:"status_id"`
+	StatusValue *string `db:"status_value"`
+}
+type PublisherAggregate struct {
+	Count int64                    `db:"count"`
+	Min   PublisherAggregateMinMax `db:"min"`
+	Max   PublisherAggregateMinMax `db:"max"`
+}
+
+type PublisherAggregateMinMax struct {
+	PublisherId   *int    `db:"publisher_id"`
+	PublisherName *string `db:"publisher_name"`
+}
+type ShippingMethodAggregate struct {
+	Count int64                         `db:"count"`
+	Sum   ShippingMethodAggregateSum    `db:"sum"`
+	Avg   ShippingMethodAggregateAvg    `db:"avg"`
+	Min   ShippingMethodAggregateMinMax `db:"min"`
+	Max   ShippingMethodAggregateMinMax `db:"max"`
+}
+
+type ShippingMethodAggregateSum struct {
+	Cost pgtype.Numeric `db:"cost"`
+}
+
+type ShippingMethodAggregateAvg struct {
+	Cost pgtype.Numeric `db:"cost"`
+}
+
+type ShippingMethodAggregateMinMax struct {
+	MethodId   *int           `db:"method_id"`
+	MethodName *string        `db:"method_name"`
+	Cost       pgtype.Numeric `db:"cost"`
 }
 
 func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
@@ -99,7 +309,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "Address",
 		Fields: graphql.Fields{
 			"address_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*Address).AddressId, nil
 				},
@@ -134,7 +344,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "AddressStatus",
 		Fields: graphql.Fields{
 			"status_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*AddressStatus).StatusId, nil
 				},
@@ -151,7 +361,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "Author",
 		Fields: graphql.Fields{
 			"author_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*Author).AuthorId, nil
 				},
@@ -168,7 +378,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "Book",
 		Fields: graphql.Fields{
 			"book_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*Book).BookId, nil
 				},
@@ -215,13 +425,13 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "BookAuthor",
 		Fields: graphql.Fields{
 			"book_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*BookAuthor).BookId, nil
 				},
 			},
 			"author_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*BookAuthor).AuthorId, nil
 				},
@@ -232,7 +442,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "BookLanguage",
 		Fields: graphql.Fields{
 			"language_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*BookLanguage).LanguageId, nil
 				},
@@ -255,7 +465,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "Country",
 		Fields: graphql.Fields{
 			"country_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*Country).CountryId, nil
 				},
@@ -272,7 +482,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "CustOrder",
 		Fields: graphql.Fields{
 			"order_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*CustOrder).OrderId, nil
 				},
@@ -307,7 +517,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "Customer",
 		Fields: graphql.Fields{
 			"customer_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*Customer).CustomerId, nil
 				},
@@ -336,13 +546,13 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "CustomerAddress",
 		Fields: graphql.Fields{
 			"customer_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*CustomerAddress).CustomerId, nil
 				},
 			},
 			"address_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*CustomerAddress).AddressId, nil
 				},
@@ -359,7 +569,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "OrderHistory",
 		Fields: graphql.Fields{
 			"history_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*OrderHistory).HistoryId, nil
 				},
@@ -388,7 +598,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "OrderLine",
 		Fields: graphql.Fields{
 			"line_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*OrderLine).LineId, nil
 				},
@@ -417,7 +627,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "OrderStatus",
 		Fields: graphql.Fields{
 			"status_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*OrderStatus).StatusId, nil
 				},
@@ -434,7 +644,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "Publisher",
 		Fields: graphql.Fields{
 			"publisher_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*Publisher).PublisherId, nil
 				},
@@ -451,7 +661,7 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		Name: "ShippingMethod",
 		Fields: graphql.Fields{
 			"method_id": &graphql.Field{
-				Type: graphql.Int,
+				Type: graphql.NewNonNull(graphql.Int),
 				Resolve: func(p graphql.ResolveParams) (any, error) {
 					return p.Source.(*ShippingMethod).MethodId, nil
 				},
@@ -470,121 +680,1057 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			},
 		},
 	})
-	addressInput := graphql.NewInputObject(graphql.InputObjectConfig{
-		Name: "AddressInput",
-		Fields: graphql.InputObjectConfigFieldMap{
-			"address_id": &graphql.InputObjectFieldConfig{
+	addressAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AddressAggregateMinMax",
+		Fields: graphql.Fields{
+			"address_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressAggregateMinMax).AddressId, nil
+				},
 			},
-
-			"street_number": &graphql.InputObjectFieldConfig{
+			"street_number": &graphql.Field{
 				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressAggregateMinMax).StreetNumber, nil
+				},
 			},
-
-			"street_name": &graphql.InputObjectFieldConfig{
+			"street_name": &graphql.Field{
 				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressAggregateMinMax).StreetName, nil
+				},
 			},
-
-			"city": &graphql.InputObjectFieldConfig{
+			"city": &graphql.Field{
 				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressAggregateMinMax).City, nil
+				},
 			},
-
-			"country_id": &graphql.InputObjectFieldConfig{
+			"country_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressAggregateMinMax).CountryId, nil
+				},
 			},
 		},
 	})
-	addressStatusInput := graphql.NewInputObject(graphql.InputObjectConfig{
-		Name: "AddressStatusInput",
-		Fields: graphql.InputObjectConfigFieldMap{
-			"status_id": &graphql.InputObjectFieldConfig{
+	addressAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AddressAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(addressAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*AddressAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(addressAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*AddressAggregate).Max, nil
+				},
+			},
+		},
+	})
+	addressStatusAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AddressStatusAggregateMinMax",
+		Fields: graphql.Fields{
+			"status_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressStatusAggregateMinMax).StatusId, nil
+				},
 			},
-
-			"address_status": &graphql.InputObjectFieldConfig{
+			"address_status": &graphql.Field{
 				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressStatusAggregateMinMax).AddressStatus, nil
+				},
 			},
 		},
 	})
-	authorInput := graphql.NewInputObject(graphql.InputObjectConfig{
-		Name: "AuthorInput",
-		Fields: graphql.InputObjectConfigFieldMap{
-			"author_id": &graphql.InputObjectFieldConfig{
+	addressStatusAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AddressStatusAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AddressStatusAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(addressStatusAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*AddressStatusAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(addressStatusAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*AddressStatusAggregate).Max, nil
+				},
+			},
+		},
+	})
+	authorAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AuthorAggregateMinMax",
+		Fields: graphql.Fields{
+			"author_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AuthorAggregateMinMax).AuthorId, nil
+				},
 			},
-
-			"author_name": &graphql.InputObjectFieldConfig{
+			"author_name": &graphql.Field{
 				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AuthorAggregateMinMax).AuthorName, nil
+				},
 			},
 		},
 	})
-	bookInput := graphql.NewInputObject(graphql.InputObjectConfig{
-		Name: "BookInput",
-		Fields: graphql.InputObjectConfigFieldMap{
-			"book_id": &graphql.InputObjectFieldConfig{
+	authorAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AuthorAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*AuthorAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(authorAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*AuthorAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(authorAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*AuthorAggregate).Max, nil
+				},
+			},
+		},
+	})
+	bookAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BookAggregateMinMax",
+		Fields: graphql.Fields{
+			"book_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregateMinMax).BookId, nil
+				},
 			},
-
-			"title": &graphql.InputObjectFieldConfig{
+			"title": &graphql.Field{
 				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregateMinMax).Title, nil
+				},
 			},
-
-			"isbn13": &graphql.InputObjectFieldConfig{
+			"isbn13": &graphql.Field{
 				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregateMinMax).Isbn13, nil
+				},
 			},
-
-			"language_id": &graphql.InputObjectFieldConfig{
+			"language_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregateMinMax).LanguageId, nil
+				},
 			},
-
-			"num_pages": &graphql.InputObjectFieldConfig{
+			"num_pages": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregateMinMax).NumPages, nil
+				},
 			},
-
-			"publication_date": &graphql.InputObjectFieldConfig{
+			"publication_date": &graphql.Field{
 				Type: scalar.Date,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregateMinMax).PublicationDate, nil
+				},
 			},
-
-			"publisher_id": &graphql.InputObjectFieldConfig{
+			"publisher_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregateMinMax).PublisherId, nil
+				},
 			},
 		},
 	})
-	bookAuthorInput := graphql.NewInputObject(graphql.InputObjectConfig{
-		Name: "BookAuthorInput",
-		Fields: graphql.InputObjectConfigFieldMap{
-			"book_id": &graphql.InputObjectFieldConfig{
+	bookAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BookAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAggregate).Count, nil
+				},
+			},
+			"sum": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "BookAggregateSum",
+					Fields: graphql.Fields{
+						"num_pages": &graphql.Field{
+							Type: scalar.BigInt,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*BookAggregateSum).NumPages, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookAggregate).Sum, nil
+				},
+			},
+			"avg": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "BookAggregateAvg",
+					Fields: graphql.Fields{
+						"num_pages": &graphql.Field{
+							Type: scalar.Numeric,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*BookAggregateAvg).NumPages, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookAggregate).Avg, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(bookAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(bookAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookAggregate).Max, nil
+				},
+			},
+		},
+	})
+	bookAuthorAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BookAuthorAggregateMinMax",
+		Fields: graphql.Fields{
+			"book_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAuthorAggregateMinMax).BookId, nil
+				},
 			},
-
-			"author_id": &graphql.InputObjectFieldConfig{
+			"author_id": &graphql.Field{
 				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAuthorAggregateMinMax).AuthorId, nil
+				},
 			},
 		},
 	})
-	bookLanguageInput := graphql.NewInputObject(graphql.InputObjectConfig{
-		Name: "BookLanguageInput",
-		Fields: graphql.InputObjectConfigFieldMap{
-			"language_id": &graphql.InputObjectFieldConfig{
-				Type: graphql.Int,
+	bookAuthorAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BookAuthorAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookAuthorAggregate).Count, nil
+				},
 			},
-
-			"language_code": &graphql.InputObjectFieldConfig{
-				Type: graphql.String,
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(bookAuthorAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookAuthorAggregate).Min, nil
+				},
 			},
-
-			"language_name": &graphql.InputObjectFieldConfig{
-				Type: graphql.String,
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(bookAuthorAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookAuthorAggregate).Max, nil
+				},
 			},
 		},
 	})
-	countryInput := graphql.NewInputObject(graphql.InputObjectConfig{
+	bookLanguageAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BookLanguageAggregateMinMax",
+		Fields: graphql.Fields{
+			"language_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookLanguageAggregateMinMax).LanguageId, nil
+				},
+			},
+			"language_code": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookLanguageAggregateMinMax).LanguageCode, nil
+				},
+			},
+			"language_name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookLanguageAggregateMinMax).LanguageName, nil
+				},
+			},
+		},
+	})
+	bookLanguageAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BookLanguageAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*BookLanguageAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(bookLanguageAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookLanguageAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(bookLanguageAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*BookLanguageAggregate).Max, nil
+				},
+			},
+		},
+	})
+	countryAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CountryAggregateMinMax",
+		Fields: graphql.Fields{
+			"country_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CountryAggregateMinMax).CountryId, nil
+				},
+			},
+			"country_name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CountryAggregateMinMax).CountryName, nil
+				},
+			},
+		},
+	})
+	countryAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CountryAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CountryAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(countryAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CountryAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(countryAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CountryAggregate).Max, nil
+				},
+			},
+		},
+	})
+	custOrderAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustOrderAggregateMinMax",
+		Fields: graphql.Fields{
+			"order_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustOrderAggregateMinMax).OrderId, nil
+				},
+			},
+			"order_date": &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustOrderAggregateMinMax).OrderDate, nil
+				},
+			},
+			"customer_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustOrderAggregateMinMax).CustomerId, nil
+				},
+			},
+			"shipping_method_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustOrderAggregateMinMax).ShippingMethodId, nil
+				},
+			},
+			"dest_address_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustOrderAggregateMinMax).DestAddressId, nil
+				},
+			},
+		},
+	})
+	custOrderAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustOrderAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustOrderAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(custOrderAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustOrderAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(custOrderAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustOrderAggregate).Max, nil
+				},
+			},
+		},
+	})
+	customerAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustomerAggregateMinMax",
+		Fields: graphql.Fields{
+			"customer_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAggregateMinMax).CustomerId, nil
+				},
+			},
+			"first_name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAggregateMinMax).FirstName, nil
+				},
+			},
+			"last_name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAggregateMinMax).LastName, nil
+				},
+			},
+			"email": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAggregateMinMax).Email, nil
+				},
+			},
+		},
+	})
+	customerAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustomerAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(customerAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustomerAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(customerAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustomerAggregate).Max, nil
+				},
+			},
+		},
+	})
+	customerAddressAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustomerAddressAggregateMinMax",
+		Fields: graphql.Fields{
+			"customer_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAddressAggregateMinMax).CustomerId, nil
+				},
+			},
+			"address_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAddressAggregateMinMax).AddressId, nil
+				},
+			},
+			"status_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAddressAggregateMinMax).StatusId, nil
+				},
+			},
+		},
+	})
+	customerAddressAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CustomerAddressAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*CustomerAddressAggregate).Count, nil
+				},
+			},
+			"sum": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "CustomerAddressAggregateSum",
+					Fields: graphql.Fields{
+						"status_id": &graphql.Field{
+							Type: scalar.BigInt,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*CustomerAddressAggregateSum).StatusId, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustomerAddressAggregate).Sum, nil
+				},
+			},
+			"avg": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "CustomerAddressAggregateAvg",
+					Fields: graphql.Fields{
+						"status_id": &graphql.Field{
+							Type: scalar.Numeric,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*CustomerAddressAggregateAvg).StatusId, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustomerAddressAggregate).Avg, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(customerAddressAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustomerAddressAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(customerAddressAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*CustomerAddressAggregate).Max, nil
+				},
+			},
+		},
+	})
+	orderHistoryAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "OrderHistoryAggregateMinMax",
+		Fields: graphql.Fields{
+			"history_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderHistoryAggregateMinMax).HistoryId, nil
+				},
+			},
+			"order_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderHistoryAggregateMinMax).OrderId, nil
+				},
+			},
+			"status_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderHistoryAggregateMinMax).StatusId, nil
+				},
+			},
+			"status_date": &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderHistoryAggregateMinMax).StatusDate, nil
+				},
+			},
+		},
+	})
+	orderHistoryAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "OrderHistoryAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderHistoryAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(orderHistoryAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderHistoryAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(orderHistoryAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderHistoryAggregate).Max, nil
+				},
+			},
+		},
+	})
+	orderLineAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "OrderLineAggregateMinMax",
+		Fields: graphql.Fields{
+			"line_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderLineAggregateMinMax).LineId, nil
+				},
+			},
+			"order_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderLineAggregateMinMax).OrderId, nil
+				},
+			},
+			"book_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderLineAggregateMinMax).BookId, nil
+				},
+			},
+			"price": &graphql.Field{
+				Type: scalar.Numeric,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderLineAggregateMinMax).Price, nil
+				},
+			},
+		},
+	})
+	orderLineAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "OrderLineAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderLineAggregate).Count, nil
+				},
+			},
+			"sum": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "OrderLineAggregateSum",
+					Fields: graphql.Fields{
+						"price": &graphql.Field{
+							Type: scalar.Numeric,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*OrderLineAggregateSum).Price, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderLineAggregate).Sum, nil
+				},
+			},
+			"avg": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "OrderLineAggregateAvg",
+					Fields: graphql.Fields{
+						"price": &graphql.Field{
+							Type: scalar.Numeric,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*OrderLineAggregateAvg).Price, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderLineAggregate).Avg, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(orderLineAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderLineAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(orderLineAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderLineAggregate).Max, nil
+				},
+			},
+		},
+	})
+	orderStatusAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "OrderStatusAggregateMinMax",
+		Fields: graphql.Fields{
+			"status_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderStatusAggregateMinMax).StatusId, nil
+				},
+			},
+			"status_value": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderStatusAggregateMinMax).StatusValue, nil
+				},
+			},
+		},
+	})
+	orderStatusAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "OrderStatusAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*OrderStatusAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(orderStatusAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderStatusAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(orderStatusAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*OrderStatusAggregate).Max, nil
+				},
+			},
+		},
+	})
+	publisherAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PublisherAggregateMinMax",
+		Fields: graphql.Fields{
+			"publisher_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*PublisherAggregateMinMax).PublisherId, nil
+				},
+			},
+			"publisher_name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*PublisherAggregateMinMax).PublisherName, nil
+				},
+			},
+		},
+	})
+	publisherAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PublisherAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*PublisherAggregate).Count, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(publisherAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*PublisherAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(publisherAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*PublisherAggregate).Max, nil
+				},
+			},
+		},
+	})
+	shippingMethodAggregateMinMaxType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ShippingMethodAggregateMinMax",
+		Fields: graphql.Fields{
+			"method_id": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*ShippingMethodAggregateMinMax).MethodId, nil
+				},
+			},
+			"method_name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*ShippingMethodAggregateMinMax).MethodName, nil
+				},
+			},
+			"cost": &graphql.Field{
+				Type: scalar.Numeric,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*ShippingMethodAggregateMinMax).Cost, nil
+				},
+			},
+		},
+	})
+	shippingMethodAggregateType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ShippingMethodAggregate",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*ShippingMethodAggregate).Count, nil
+				},
+			},
+			"sum": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "ShippingMethodAggregateSum",
+					Fields: graphql.Fields{
+						"cost": &graphql.Field{
+							Type: scalar.Numeric,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*ShippingMethodAggregateSum).Cost, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*ShippingMethodAggregate).Sum, nil
+				},
+			},
+			"avg": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+					Name: "ShippingMethodAggregateAvg",
+					Fields: graphql.Fields{
+						"cost": &graphql.Field{
+							Type: scalar.Numeric,
+							Resolve: func(p graphql.ResolveParams) (any, error) {
+								return p.Source.(*ShippingMethodAggregateAvg).Cost, nil
+							},
+						},
+					},
+				})),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*ShippingMethodAggregate).Avg, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(shippingMethodAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*ShippingMethodAggregate).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(shippingMethodAggregateMinMaxType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return &p.Source.(*ShippingMethodAggregate).Max, nil
+				},
+			},
+		},
+	})
+	addressInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AddressInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"address_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"street_number": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"street_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"city": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"country_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	addressInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AddressInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"address_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"street_number": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"street_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"city": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"country_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	addressStatusInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AddressStatusInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"status_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"address_status": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	addressStatusInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AddressStatusInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"status_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"address_status": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	authorInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AuthorInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"author_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"author_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	authorInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AuthorInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"author_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"author_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	bookInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "BookInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"book_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"title": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"isbn13": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"language_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"num_pages": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"publication_date": &graphql.InputObjectFieldConfig{
+				Type: scalar.Date,
+			},
+			"publisher_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	bookInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "BookInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"book_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"title": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"isbn13": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"language_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"num_pages": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"publication_date": &graphql.InputObjectFieldConfig{
+				Type: scalar.Date,
+			},
+			"publisher_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	bookAuthorInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "BookAuthorInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"book_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"author_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	bookAuthorInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "BookAuthorInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"book_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"author_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+		},
+	})
+	bookLanguageInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "BookLanguageInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"language_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"language_code": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"language_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	bookLanguageInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "BookLanguageInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"language_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"language_code": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"language_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	countryInput := graphql.NewInputObject(graphql.InputObjectConfig{
 		Name: "CountryInput",
 		Fields: graphql.InputObjectConfigFieldMap{
 			"country_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
+			"country_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	countryInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CountryInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"country_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
 			"country_name": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
@@ -596,19 +1742,35 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"order_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"order_date": &graphql.InputObjectFieldConfig{
 				Type: graphql.DateTime,
 			},
-
 			"customer_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"shipping_method_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
+			"dest_address_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	custOrderInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CustOrderInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"order_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"order_date": &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+			"customer_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"shipping_method_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
 			"dest_address_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
@@ -620,15 +1782,29 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"customer_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"first_name": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
-
 			"last_name": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
-
+			"email": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	customerInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CustomerInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"customer_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"first_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"last_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
 			"email": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
@@ -640,11 +1816,23 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"customer_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"address_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
+			"status_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
+	customerAddressInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CustomerAddressInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"customer_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"address_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
 			"status_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
@@ -656,15 +1844,29 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"history_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"order_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"status_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
+			"status_date": &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+	orderHistoryInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "OrderHistoryInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"history_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"order_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"status_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
 			"status_date": &graphql.InputObjectFieldConfig{
 				Type: graphql.DateTime,
 			},
@@ -676,15 +1878,29 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"line_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"order_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"book_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
+			"price": &graphql.InputObjectFieldConfig{
+				Type: scalar.Numeric,
+			},
+		},
+	})
+	orderLineInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "OrderLineInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"line_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"order_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+			"book_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
 			"price": &graphql.InputObjectFieldConfig{
 				Type: scalar.Numeric,
 			},
@@ -696,7 +1912,17 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"status_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
+			"status_value": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	orderStatusInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "OrderStatusInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"status_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
 			"status_value": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
@@ -708,7 +1934,17 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"publisher_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
+			"publisher_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+		},
+	})
+	publisherInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PublisherInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"publisher_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
 			"publisher_name": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
@@ -720,17 +1956,109 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			"method_id": &graphql.InputObjectFieldConfig{
 				Type: graphql.Int,
 			},
-
 			"method_name": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
-
 			"cost": &graphql.InputObjectFieldConfig{
 				Type: scalar.Numeric,
 			},
 		},
 	})
-	addressFilter := filter.NewArgumentConfig("AddressFilter", graphql.InputObjectConfigFieldMap{
+	shippingMethodInsertInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ShippingMethodInsertInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"method_id": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"method_name": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"cost": &graphql.InputObjectFieldConfig{
+				Type: scalar.Numeric,
+			},
+		},
+	})
+	addressTable := sqlgen.NewTable("public", "address", map[string]string{
+		"address_id":    "address_id",
+		"street_number": "street_number",
+		"street_name":   "street_name",
+		"city":          "city",
+		"country_id":    "country_id",
+	})
+	addressStatusTable := sqlgen.NewTable("public", "address_status", map[string]string{
+		"status_id":      "status_id",
+		"address_status": "address_status",
+	})
+	authorTable := sqlgen.NewTable("public", "author", map[string]string{
+		"author_id":   "author_id",
+		"author_name": "author_name",
+	})
+	bookTable := sqlgen.NewTable("public", "book", map[string]string{
+		"book_id":          "book_id",
+		"title":            "title",
+		"isbn13":           "isbn13",
+		"language_id":      "language_id",
+		"num_pages":        "num_pages",
+		"publication_date": "publication_date",
+		"publisher_id":     "publisher_id",
+	})
+	bookAuthorTable := sqlgen.NewTable("public", "book_author", map[string]string{
+		"book_id":   "book_id",
+		"author_id": "author_id",
+	})
+	bookLanguageTable := sqlgen.NewTable("public", "book_language", map[string]string{
+		"language_id":   "language_id",
+		"language_code": "language_code",
+		"language_name": "language_name",
+	})
+	countryTable := sqlgen.NewTable("public", "country", map[string]string{
+		"country_id":   "country_id",
+		"country_name": "country_name",
+	})
+	custOrderTable := sqlgen.NewTable("public", "cust_order", map[string]string{
+		"order_id":           "order_id",
+		"order_date":         "order_date",
+		"customer_id":        "customer_id",
+		"shipping_method_id": "shipping_method_id",
+		"dest_address_id":    "dest_address_id",
+	})
+	customerTable := sqlgen.NewTable("public", "customer", map[string]string{
+		"customer_id": "customer_id",
+		"first_name":  "first_name",
+		"last_name":   "last_name",
+		"email":       "email",
+	})
+	customerAddressTable := sqlgen.NewTable("public", "customer_address", map[string]string{
+		"customer_id": "customer_id",
+		"address_id":  "address_id",
+		"status_id":   "status_id",
+	})
+	orderHistoryTable := sqlgen.NewTable("public", "order_history", map[string]string{
+		"history_id":  "history_id",
+		"order_id":    "order_id",
+		"status_id":   "status_id",
+		"status_date": "status_date",
+	})
+	orderLineTable := sqlgen.NewTable("public", "order_line", map[string]string{
+		"line_id":  "line_id",
+		"order_id": "order_id",
+		"book_id":  "book_id",
+		"price":    "price",
+	})
+	orderStatusTable := sqlgen.NewTable("public", "order_status", map[string]string{
+		"status_id":    "status_id",
+		"status_value": "status_value",
+	})
+	publisherTable := sqlgen.NewTable("public", "publisher", map[string]string{
+		"publisher_id":   "publisher_id",
+		"publisher_name": "publisher_name",
+	})
+	shippingMethodTable := sqlgen.NewTable("public", "shipping_method", map[string]string{
+		"method_id":   "method_id",
+		"method_name": "method_name",
+		"cost":        "cost",
+	})
+	addressFilterFields := graphql.InputObjectConfigFieldMap{
 		"address_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -746,24 +2074,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"country_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
-	})
-	addressStatusFilter := filter.NewArgumentConfig("AddressStatusFilter", graphql.InputObjectConfigFieldMap{
+	}
+	addressFilter := filter.NewArgumentConfig("AddressFilter", addressFilterFields)
+	addressStatusFilterFields := graphql.InputObjectConfigFieldMap{
 		"status_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
 		"address_status": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-	authorFilter := filter.NewArgumentConfig("AuthorFilter", graphql.InputObjectConfigFieldMap{
+	}
+	addressStatusFilter := filter.NewArgumentConfig("AddressStatusFilter", addressStatusFilterFields)
+	authorFilterFields := graphql.InputObjectConfigFieldMap{
 		"author_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
 		"author_name": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-	bookFilter := filter.NewArgumentConfig("BookFilter", graphql.InputObjectConfigFieldMap{
+	}
+	authorFilter := filter.NewArgumentConfig("AuthorFilter", authorFilterFields)
+	bookFilterFields := graphql.InputObjectConfigFieldMap{
 		"book_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -779,19 +2110,24 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"num_pages": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
+		"publication_date": &graphql.InputObjectFieldConfig{
+			Type: filter.Date,
+		},
 		"publisher_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
-	})
-	bookAuthorFilter := filter.NewArgumentConfig("BookAuthorFilter", graphql.InputObjectConfigFieldMap{
+	}
+	bookFilter := filter.NewArgumentConfig("BookFilter", bookFilterFields)
+	bookAuthorFilterFields := graphql.InputObjectConfigFieldMap{
 		"book_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
 		"author_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
-	})
-	bookLanguageFilter := filter.NewArgumentConfig("BookLanguageFilter", graphql.InputObjectConfigFieldMap{
+	}
+	bookAuthorFilter := filter.NewArgumentConfig("BookAuthorFilter", bookAuthorFilterFields)
+	bookLanguageFilterFields := graphql.InputObjectConfigFieldMap{
 		"language_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -801,19 +2137,24 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"language_name": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-	countryFilter := filter.NewArgumentConfig("CountryFilter", graphql.InputObjectConfigFieldMap{
+	}
+	bookLanguageFilter := filter.NewArgumentConfig("BookLanguageFilter", bookLanguageFilterFields)
+	countryFilterFields := graphql.InputObjectConfigFieldMap{
 		"country_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
 		"country_name": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-	custOrderFilter := filter.NewArgumentConfig("CustOrderFilter", graphql.InputObjectConfigFieldMap{
+	}
+	countryFilter := filter.NewArgumentConfig("CountryFilter", countryFilterFields)
+	custOrderFilterFields := graphql.InputObjectConfigFieldMap{
 		"order_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
+		"order_date": &graphql.InputObjectFieldConfig{
+			Type: filter.DateTime,
+		},
 		"customer_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -823,8 +2164,9 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"dest_address_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
-	})
-	customerFilter := filter.NewArgumentConfig("CustomerFilter", graphql.InputObjectConfigFieldMap{
+	}
+	custOrderFilter := filter.NewArgumentConfig("CustOrderFilter", custOrderFilterFields)
+	customerFilterFields := graphql.InputObjectConfigFieldMap{
 		"customer_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -837,8 +2179,9 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"email": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-	customerAddressFilter := filter.NewArgumentConfig("CustomerAddressFilter", graphql.InputObjectConfigFieldMap{
+	}
+	customerFilter := filter.NewArgumentConfig("CustomerFilter", customerFilterFields)
+	customerAddressFilterFields := graphql.InputObjectConfigFieldMap{
 		"customer_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -848,8 +2191,9 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"status_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
-	})
-	orderHistoryFilter := filter.NewArgumentConfig("OrderHistoryFilter", graphql.InputObjectConfigFieldMap{
+	}
+	customerAddressFilter := filter.NewArgumentConfig("CustomerAddressFilter", customerAddressFilterFields)
+	orderHistoryFilterFields := graphql.InputObjectConfigFieldMap{
 		"history_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -859,8 +2203,12 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"status_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
-	})
-	orderLineFilter := filter.NewArgumentConfig("OrderLineFilter", graphql.InputObjectConfigFieldMap{
+		"status_date": &graphql.InputObjectFieldConfig{
+			Type: filter.DateTime,
+		},
+	}
+	orderHistoryFilter := filter.NewArgumentConfig("OrderHistoryFilter", orderHistoryFilterFields)
+	orderLineFilterFields := graphql.InputObjectConfigFieldMap{
 		"line_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
@@ -870,451 +2218,236 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 		"book_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
-	})
-	orderStatusFilter := filter.NewArgumentConfig("OrderStatusFilter", graphql.InputObjectConfigFieldMap{
+		"price": &graphql.InputObjectFieldConfig{
+			Type: filter.Numeric,
+		},
+	}
+	orderLineFilter := filter.NewArgumentConfig("OrderLineFilter", orderLineFilterFields)
+	orderStatusFilterFields := graphql.InputObjectConfigFieldMap{
 		"status_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
 		"status_value": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-	publisherFilter := filter.NewArgumentConfig("PublisherFilter", graphql.InputObjectConfigFieldMap{
+	}
+	orderStatusFilter := filter.NewArgumentConfig("OrderStatusFilter", orderStatusFilterFields)
+	publisherFilterFields := graphql.InputObjectConfigFieldMap{
 		"publisher_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
 		"publisher_name": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-	shippingMethodFilter := filter.NewArgumentConfig("ShippingMethodFilter", graphql.InputObjectConfigFieldMap{
+	}
+	publisherFilter := filter.NewArgumentConfig("PublisherFilter", publisherFilterFields)
+	shippingMethodFilterFields := graphql.InputObjectConfigFieldMap{
 		"method_id": &graphql.InputObjectFieldConfig{
 			Type: filter.Int,
 		},
 		"method_name": &graphql.InputObjectFieldConfig{
 			Type: filter.String,
 		},
-	})
-
-	customerAddressAddressLoader := batcher.NewLoader(
-		pq,
-		func(v *Address) int {
-			return v.AddressId
-		},
-		"select address_id,street_number,street_name,city,country_id from address where 1=1 and address_id = any($1)",
-	)
-	customerAddressType.AddFieldConfig("address", &graphql.Field{
-		Type: addressType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := customerAddressAddressLoader.Load(p.Context, p.Source.(*CustomerAddress).AddressId)
-			return func() (any, error) { return thunk() }, nil
-		},
-	})
-
-	addressCustomerAddressLoader := batcher.NewListLoader(
-		pq,
-		func(v *CustomerAddress) int {
-			return v.AddressId
-		},
-		"select customer_id,address_id,status_id from customer_address where 1=1 and address_id = any($1)",
-	)
-	addressType.AddFieldConfig("fk_ca_addr", &graphql.Field{
-		Type: graphql.NewList(customerAddressType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := addressCustomerAddressLoader.Load(p.Context, p.Source.(*Address).AddressId)
-			return func() (any, error) { return thunk() }, nil
-		},
-	})
-
-	custOrderAddressLoader := batcher.NewLoader(
-		pq,
-		func(v *Address) int {
-			return v.AddressId
-		},
-		"select address_id,street_number,street_name,city,country_id from address where 1=1 and address_id = any($1)",
-	)
-	custOrderType.AddFieldConfig("address", &graphql.Field{
-		Type: addressType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := custOrderAddressLoader.Load(p.Context, p.Source.(*CustOrder).DestAddressId)
-			return func() (any, error) { return thunk() }, nil
-		},
-	})
-
-	addressCustOrderLoader := batcher.NewListLoader(
-		pq,
-		func(v *CustOrder) int {
-			return v.DestAddressId
-		},
-		"select order_id,order_date,customer_id,shipping_method_id,dest_address_id from cust_order where 1=1 and dest_address_id = any($1)",
-	)
-	addressType.AddFieldConfig("fk_order_addr", &graphql.Field{
-		Type: graphql.NewList(custOrderType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := addressCustOrderLoader.Load(p.Context, p.Source.(*Address).AddressId)
-			return func() (any, error) { return thunk() }, nil
-		},
-	})
-
-	bookAuthorAuthorLoader := batcher.NewLoader(
-		pq,
-		func(v *Author) int {
-			return v.AuthorId
+		"cost": &graphql.InputObjectFieldConfig{
+			Type: filter.Numeric,
 		},
-		"select author_id,author_name from author where 1=1 and author_id = any($1)",
-	)
-	bookAuthorType.AddFieldConfig("author", &graphql.Field{
-		Type: authorType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := bookAuthorAuthorLoader.Load(p.Context, p.Source.(*BookAuthor).AuthorId)
-			return func() (any, error) { return thunk() }, nil
+	}
+	shippingMethodFilter := filter.NewArgumentConfig("ShippingMethodFilter", shippingMethodFilterFields)
+	addressOrderBy := filter.NewOrderByArgumentConfig("AddressOrderBy", graphql.InputObjectConfigFieldMap{
+		"address_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	authorBookAuthorLoader := batcher.NewListLoader(
-		pq,
-		func(v *BookAuthor) int {
-			return v.AuthorId
+		"street_number": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select book_id,author_id from book_author where 1=1 and author_id = any($1)",
-	)
-	authorType.AddFieldConfig("fk_ba_author", &graphql.Field{
-		Type: graphql.NewList(bookAuthorType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := authorBookAuthorLoader.Load(p.Context, p.Source.(*Author).AuthorId)
-			return func() (any, error) { return thunk() }, nil
+		"street_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	bookAuthorBookLoader := batcher.NewLoader(
-		pq,
-		func(v *Book) int {
-			return v.BookId
+		"city": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id from book where 1=1 and book_id = any($1)",
-	)
-	bookAuthorType.AddFieldConfig("book", &graphql.Field{
-		Type: bookType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := bookAuthorBookLoader.Load(p.Context, p.Source.(*BookAuthor).BookId)
-			return func() (any, error) { return thunk() }, nil
+		"country_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	bookBookAuthorLoader := batcher.NewListLoader(
-		pq,
-		func(v *BookAuthor) int {
-			return v.BookId
+	addressStatusOrderBy := filter.NewOrderByArgumentConfig("AddressStatusOrderBy", graphql.InputObjectConfigFieldMap{
+		"status_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select book_id,author_id from book_author where 1=1 and book_id = any($1)",
-	)
-	bookType.AddFieldConfig("fk_ba_book", &graphql.Field{
-		Type: graphql.NewList(bookAuthorType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := bookBookAuthorLoader.Load(p.Context, p.Source.(*Book).BookId)
-			return func() (any, error) { return thunk() }, nil
+		"address_status": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	orderLineBookLoader := batcher.NewLoader(
-		pq,
-		func(v *Book) int {
-			return v.BookId
+	authorOrderBy := filter.NewOrderByArgumentConfig("AuthorOrderBy", graphql.InputObjectConfigFieldMap{
+		"author_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id from book where 1=1 and book_id = any($1)",
-	)
-	orderLineType.AddFieldConfig("book", &graphql.Field{
-		Type: bookType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := orderLineBookLoader.Load(p.Context, p.Source.(*OrderLine).BookId)
-			return func() (any, error) { return thunk() }, nil
+		"author_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	bookOrderLineLoader := batcher.NewListLoader(
-		pq,
-		func(v *OrderLine) int {
-			return v.BookId
-		},
-		"select line_id,order_id,book_id,price from order_line where 1=1 and book_id = any($1)",
-	)
-	bookType.AddFieldConfig("fk_ol_book", &graphql.Field{
-		Type: graphql.NewList(orderLineType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := bookOrderLineLoader.Load(p.Context, p.Source.(*Book).BookId)
-			return func() (any, error) { return thunk() }, nil
+	bookOrderBy := filter.NewOrderByArgumentConfig("BookOrderBy", graphql.InputObjectConfigFieldMap{
+		"book_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	bookBookLanguageLoader := batcher.NewLoader(
-		pq,
-		func(v *BookLanguage) int {
-			return v.LanguageId
+		"title": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select language_id,language_code,language_name from book_language where 1=1 and language_id = any($1)",
-	)
-	bookType.AddFieldConfig("book_language", &graphql.Field{
-		Type: bookLanguageType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := bookBookLanguageLoader.Load(p.Context, p.Source.(*Book).LanguageId)
-			return func() (any, error) { return thunk() }, nil
+		"isbn13": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	bookLanguageBookLoader := batcher.NewListLoader(
-		pq,
-		func(v *Book) int {
-			return v.LanguageId
+		"language_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id from book where 1=1 and language_id = any($1)",
-	)
-	bookLanguageType.AddFieldConfig("fk_book_lang", &graphql.Field{
-		Type: graphql.NewList(bookType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := bookLanguageBookLoader.Load(p.Context, p.Source.(*BookLanguage).LanguageId)
-			return func() (any, error) { return thunk() }, nil
+		"num_pages": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	addressCountryLoader := batcher.NewLoader(
-		pq,
-		func(v *Country) int {
-			return v.CountryId
+		"publication_date": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select country_id,country_name from country where 1=1 and country_id = any($1)",
-	)
-	addressType.AddFieldConfig("country", &graphql.Field{
-		Type: countryType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := addressCountryLoader.Load(p.Context, p.Source.(*Address).CountryId)
-			return func() (any, error) { return thunk() }, nil
+		"publisher_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	countryAddressLoader := batcher.NewListLoader(
-		pq,
-		func(v *Address) int {
-			return v.CountryId
+	bookAuthorOrderBy := filter.NewOrderByArgumentConfig("BookAuthorOrderBy", graphql.InputObjectConfigFieldMap{
+		"book_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select address_id,street_number,street_name,city,country_id from address where 1=1 and country_id = any($1)",
-	)
-	countryType.AddFieldConfig("fk_addr_ctry", &graphql.Field{
-		Type: graphql.NewList(addressType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := countryAddressLoader.Load(p.Context, p.Source.(*Country).CountryId)
-			return func() (any, error) { return thunk() }, nil
+		"author_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	orderLineCustOrderLoader := batcher.NewLoader(
-		pq,
-		func(v *CustOrder) int {
-			return v.OrderId
-		},
-		"select order_id,order_date,customer_id,shipping_method_id,dest_address_id from cust_order where 1=1 and order_id = any($1)",
-	)
-	orderLineType.AddFieldConfig("cust_order", &graphql.Field{
-		Type: custOrderType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := orderLineCustOrderLoader.Load(p.Context, p.Source.(*OrderLine).OrderId)
-			return func() (any, error) { return thunk() }, nil
+	bookLanguageOrderBy := filter.NewOrderByArgumentConfig("BookLanguageOrderBy", graphql.InputObjectConfigFieldMap{
+		"language_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	custOrderOrderLineLoader := batcher.NewListLoader(
-		pq,
-		func(v *OrderLine) int {
-			return v.OrderId
+		"language_code": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select line_id,order_id,book_id,price from order_line where 1=1 and order_id = any($1)",
-	)
-	custOrderType.AddFieldConfig("fk_ol_order", &graphql.Field{
-		Type: graphql.NewList(orderLineType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := custOrderOrderLineLoader.Load(p.Context, p.Source.(*CustOrder).OrderId)
-			return func() (any, error) { return thunk() }, nil
+		"language_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	orderHistoryCustOrderLoader := batcher.NewLoader(
-		pq,
-		func(v *CustOrder) int {
-			return v.OrderId
+	countryOrderBy := filter.NewOrderByArgumentConfig("CountryOrderBy", graphql.InputObjectConfigFieldMap{
+		"country_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select order_id,order_date,customer_id,shipping_method_id,dest_address_id from cust_order where 1=1 and order_id = any($1)",
-	)
-	orderHistoryType.AddFieldConfig("cust_order", &graphql.Field{
-		Type: custOrderType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := orderHistoryCustOrderLoader.Load(p.Context, p.Source.(*OrderHistory).OrderId)
-			return func() (any, error) { return thunk() }, nil
+		"country_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	custOrderOrderHistoryLoader := batcher.NewListLoader(
-		pq,
-		func(v *OrderHistory) int {
-			return v.OrderId
+	custOrderOrderBy := filter.NewOrderByArgumentConfig("CustOrderOrderBy", graphql.InputObjectConfigFieldMap{
+		"order_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select history_id,order_id,status_id,status_date from order_history where 1=1 and order_id = any($1)",
-	)
-	custOrderType.AddFieldConfig("fk_oh_order", &graphql.Field{
-		Type: graphql.NewList(orderHistoryType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := custOrderOrderHistoryLoader.Load(p.Context, p.Source.(*CustOrder).OrderId)
-			return func() (any, error) { return thunk() }, nil
+		"order_date": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	customerAddressCustomerLoader := batcher.NewLoader(
-		pq,
-		func(v *Customer) int {
-			return v.CustomerId
+		"customer_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
+		},
+		"shipping_method_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select customer_id,first_name,last_name,email from customer where 1=1 and customer_id = any($1)",
-	)
-	customerAddressType.AddFieldConfig("customer", &graphql.Field{
-		Type: customerType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := customerAddressCustomerLoader.Load(p.Context, p.Source.(*CustomerAddress).CustomerId)
-			return func() (any, error) { return thunk() }, nil
+		"dest_address_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	customerCustomerAddressLoader := batcher.NewListLoader(
-		pq,
-		func(v *CustomerAddress) int {
-			return v.CustomerId
+	customerOrderBy := filter.NewOrderByArgumentConfig("CustomerOrderBy", graphql.InputObjectConfigFieldMap{
+		"customer_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select customer_id,address_id,status_id from customer_address where 1=1 and customer_id = any($1)",
-	)
-	customerType.AddFieldConfig("fk_ca_cust", &graphql.Field{
-		Type: graphql.NewList(customerAddressType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := customerCustomerAddressLoader.Load(p.Context, p.Source.(*Customer).CustomerId)
-			return func() (any, error) { return thunk() }, nil
+		"first_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	custOrderCustomerLoader := batcher.NewLoader(
-		pq,
-		func(v *Customer) int {
-			return v.CustomerId
+		"last_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select customer_id,first_name,last_name,email from customer where 1=1 and customer_id = any($1)",
-	)
-	custOrderType.AddFieldConfig("customer", &graphql.Field{
-		Type: customerType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := custOrderCustomerLoader.Load(p.Context, p.Source.(*CustOrder).CustomerId)
-			return func() (any, error) { return thunk() }, nil
+		"email": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	customerCustOrderLoader := batcher.NewListLoader(
-		pq,
-		func(v *CustOrder) int {
-			return v.CustomerId
+	customerAddressOrderBy := filter.NewOrderByArgumentConfig("CustomerAddressOrderBy", graphql.InputObjectConfigFieldMap{
+		"customer_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
+		},
+		"address_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select order_id,order_date,customer_id,shipping_method_id,dest_address_id from cust_order where 1=1 and customer_id = any($1)",
-	)
-	customerType.AddFieldConfig("fk_order_cust", &graphql.Field{
-		Type: graphql.NewList(custOrderType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := customerCustOrderLoader.Load(p.Context, p.Source.(*Customer).CustomerId)
-			return func() (any, error) { return thunk() }, nil
+		"status_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	orderHistoryOrderStatusLoader := batcher.NewLoader(
-		pq,
-		func(v *OrderStatus) int {
-			return v.StatusId
+	orderHistoryOrderBy := filter.NewOrderByArgumentConfig("OrderHistoryOrderBy", graphql.InputObjectConfigFieldMap{
+		"history_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select status_id,status_value from order_status where 1=1 and status_id = any($1)",
-	)
-	orderHistoryType.AddFieldConfig("order_status", &graphql.Field{
-		Type: orderStatusType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := orderHistoryOrderStatusLoader.Load(p.Context, p.Source.(*OrderHistory).StatusId)
-			return func() (any, error) { return thunk() }, nil
+		"order_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	orderStatusOrderHistoryLoader := batcher.NewListLoader(
-		pq,
-		func(v *OrderHistory) int {
-			return v.StatusId
+		"status_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select history_id,order_id,status_id,status_date from order_history where 1=1 and status_id = any($1)",
-	)
-	orderStatusType.AddFieldConfig("fk_oh_status", &graphql.Field{
-		Type: graphql.NewList(orderHistoryType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := orderStatusOrderHistoryLoader.Load(p.Context, p.Source.(*OrderStatus).StatusId)
-			return func() (any, error) { return thunk() }, nil
+		"status_date": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	bookPublisherLoader := batcher.NewLoader(
-		pq,
-		func(v *Publisher) int {
-			return v.PublisherId
+	orderLineOrderBy := filter.NewOrderByArgumentConfig("OrderLineOrderBy", graphql.InputObjectConfigFieldMap{
+		"line_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select publisher_id,publisher_name from publisher where 1=1 and publisher_id = any($1)",
-	)
-	bookType.AddFieldConfig("publisher", &graphql.Field{
-		Type: publisherType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := bookPublisherLoader.Load(p.Context, p.Source.(*Book).PublisherId)
-			return func() (any, error) { return thunk() }, nil
+		"order_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-	})
-
-	publisherBookLoader := batcher.NewListLoader(
-		pq,
-		func(v *Book) int {
-			return v.PublisherId
+		"book_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id from book where 1=1 and publisher_id = any($1)",
-	)
-	publisherType.AddFieldConfig("fk_book_pub", &graphql.Field{
-		Type: graphql.NewList(bookType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := publisherBookLoader.Load(p.Context, p.Source.(*Publisher).PublisherId)
-			return func() (any, error) { return thunk() }, nil
+		"price": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	custOrderShippingMethodLoader := batcher.NewLoader(
-		pq,
-		func(v *ShippingMethod) int {
-			return v.MethodId
+	orderStatusOrderBy := filter.NewOrderByArgumentConfig("OrderStatusOrderBy", graphql.InputObjectConfigFieldMap{
+		"status_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select method_id,method_name,cost from shipping_method where 1=1 and method_id = any($1)",
-	)
-	custOrderType.AddFieldConfig("shipping_method", &graphql.Field{
-		Type: shippingMethodType,
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := custOrderShippingMethodLoader.Load(p.Context, p.Source.(*CustOrder).ShippingMethodId)
-			return func() (any, error) { return thunk() }, nil
+		"status_value": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
-
-	shippingMethodCustOrderLoader := batcher.NewListLoader(
-		pq,
-		func(v *CustOrder) int {
-			return v.ShippingMethodId
+	publisherOrderBy := filter.NewOrderByArgumentConfig("PublisherOrderBy", graphql.InputObjectConfigFieldMap{
+		"publisher_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
-		"select order_id,order_date,customer_id,shipping_method_id,dest_address_id from cust_order where 1=1 and shipping_method_id = any($1)",
-	)
-	shippingMethodType.AddFieldConfig("fk_order_ship", &graphql.Field{
-		Type: graphql.NewList(custOrderType),
-		Resolve: func(p graphql.ResolveParams) (any, error) {
-			thunk := shippingMethodCustOrderLoader.Load(p.Context, p.Source.(*ShippingMethod).MethodId)
-			return func() (any, error) { return thunk() }, nil
+		"publisher_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
 		},
 	})
+	shippingMethodOrderBy := filter.NewOrderByArgumentConfig("ShippingMethodOrderBy", graphql.InputObjectConfigFieldMap{
+		"method_id": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
+		},
+		"method_name": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
+		},
+		"cost": &graphql.InputObjectFieldConfig{
+			Type: filter.OrderDirection,
+		},
+	})
+	addressConnection := filter.NewConnection(addressType)
+	addressStatusConnection := filter.NewConnection(addressStatusType)
+	authorConnection := filter.NewConnection(authorType)
+	bookConnection := filter.NewConnection(bookType)
+	bookAuthorConnection := filter.NewConnection(bookAuthorType)
+	bookLanguageConnection := filter.NewConnection(bookLanguageType)
+	countryConnection := filter.NewConnection(countryType)
+	custOrderConnection := filter.NewConnection(custOrderType)
+	customerConnection := filter.NewConnection(customerType)
+	customerAddressConnection := filter.NewConnection(customerAddressType)
+	orderHistoryConnection := filter.NewConnection(orderHistoryType)
+	orderLineConnection := filter.NewConnection(orderLineType)
+	orderStatusConnection := filter.NewConnection(orderStatusType)
+	publisherConnection := filter.NewConnection(publisherType)
+	shippingMethodConnection := filter.NewConnection(shippingMethodType)
 
 	return graphql.SchemaConfig{
 		Mutation: graphql.NewObject(graphql.ObjectConfig{
@@ -1324,12 +2457,14 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Type: addressType,
 					Args: graphql.FieldConfigArgument{
 						"address": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(addressInput),
+							Type: graphql.NewNonNull(addressInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*Address](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*Address](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["address"].(map[string]any)
-						return "insert into address(address_id,street_number,street_name,city,country_id)values($1,$2,$3,$4,$5)returning address_id,street_number,street_name,city,country_id", []any{set["address_id"], set["street_number"], set["street_name"], set["city"], set["country_id"]}
+						sql, args := sqlgen.Insert(addressTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"address_id","street_number","street_name","city","country_id"`)
+						return sql, args, nil
 					}),
 				},
 				"updateAddress": &graphql.Field{
@@ -1340,11 +2475,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": addressFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Address](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*Address](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["address"].(map[string]any)
-						sql, args := sqlgen.Update("address", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "address_id,street_number,street_name,city,country_id")
+						sql, args, err := sqlgen.Update(addressTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(addressTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"address_id","street_number","street_name","city","country_id"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteAddress": &graphql.Field{
@@ -1352,21 +2494,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": addressFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Address](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from address where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "address_id,street_number,street_name,city,country_id")
+					Resolve: batcher.GraphqlAll[*Address](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(addressTable, `delete from "public"."address" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"address_id","street_number","street_name","city","country_id"`)
+						return sql, args, nil
 					}),
 				},
 				"createAddressStatus": &graphql.Field{
 					Type: addressStatusType,
 					Args: graphql.FieldConfigArgument{
 						"address_status": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(addressStatusInput),
+							Type: graphql.NewNonNull(addressStatusInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["address_status"].(map[string]any)
-						return "insert into address_status(status_id,address_status)values($1,$2)returning status_id,address_status", []any{set["status_id"], set["address_status"]}
+						sql, args := sqlgen.Insert(addressStatusTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"status_id","address_status"`)
+						return sql, args, nil
 					}),
 				},
 				"updateAddressStatus": &graphql.Field{
@@ -1377,11 +2525,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": addressStatusFilter,
 					},
-					Resolve: batcher.GraphqlAll[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["address_status"].(map[string]any)
-						sql, args := sqlgen.Update("address_status", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "status_id,address_status")
+						sql, args, err := sqlgen.Update(addressStatusTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(addressStatusTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"status_id","address_status"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteAddressStatus": &graphql.Field{
@@ -1389,21 +2544,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": addressStatusFilter,
 					},
-					Resolve: batcher.GraphqlAll[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from address_status where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "status_id,address_status")
+					Resolve: batcher.GraphqlAll[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(addressStatusTable, `delete from "public"."address_status" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"status_id","address_status"`)
+						return sql, args, nil
 					}),
 				},
 				"createAuthor": &graphql.Field{
 					Type: authorType,
 					Args: graphql.FieldConfigArgument{
 						"author": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(authorInput),
+							Type: graphql.NewNonNull(authorInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*Author](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*Author](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["author"].(map[string]any)
-						return "insert into author(author_id,author_name)values($1,$2)returning author_id,author_name", []any{set["author_id"], set["author_name"]}
+						sql, args := sqlgen.Insert(authorTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"author_id","author_name"`)
+						return sql, args, nil
 					}),
 				},
 				"updateAuthor": &graphql.Field{
@@ -1414,11 +2575,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": authorFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Author](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*Author](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["author"].(map[string]any)
-						sql, args := sqlgen.Update("author", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "author_id,author_name")
+						sql, args, err := sqlgen.Update(authorTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(authorTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"author_id","author_name"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteAuthor": &graphql.Field{
@@ -1426,21 +2594,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": authorFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Author](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from author where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "author_id,author_name")
+					Resolve: batcher.GraphqlAll[*Author](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(authorTable, `delete from "public"."author" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"author_id","author_name"`)
+						return sql, args, nil
 					}),
 				},
 				"createBook": &graphql.Field{
 					Type: bookType,
 					Args: graphql.FieldConfigArgument{
 						"book": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(bookInput),
+							Type: graphql.NewNonNull(bookInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*Book](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*Book](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["book"].(map[string]any)
-						return "insert into book(book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id)values($1,$2,$3,$4,$5,$6,$7)returning book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id", []any{set["book_id"], set["title"], set["isbn13"], set["language_id"], set["num_pages"], set["publication_date"], set["publisher_id"]}
+						sql, args := sqlgen.Insert(bookTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"book_id","title","isbn13","language_id","num_pages","publication_date","publisher_id"`)
+						return sql, args, nil
 					}),
 				},
 				"updateBook": &graphql.Field{
@@ -1451,11 +2625,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": bookFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Book](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*Book](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["book"].(map[string]any)
-						sql, args := sqlgen.Update("book", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id")
+						sql, args, err := sqlgen.Update(bookTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(bookTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"book_id","title","isbn13","language_id","num_pages","publication_date","publisher_id"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteBook": &graphql.Field{
@@ -1463,21 +2644,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": bookFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Book](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from book where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id")
+					Resolve: batcher.GraphqlAll[*Book](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(bookTable, `delete from "public"."book" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"book_id","title","isbn13","language_id","num_pages","publication_date","publisher_id"`)
+						return sql, args, nil
 					}),
 				},
 				"createBookAuthor": &graphql.Field{
 					Type: bookAuthorType,
 					Args: graphql.FieldConfigArgument{
 						"book_author": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(bookAuthorInput),
+							Type: graphql.NewNonNull(bookAuthorInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["book_author"].(map[string]any)
-						return "insert into book_author(book_id,author_id)values($1,$2)returning book_id,author_id", []any{set["book_id"], set["author_id"]}
+						sql, args := sqlgen.Insert(bookAuthorTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"book_id","author_id"`)
+						return sql, args, nil
 					}),
 				},
 				"updateBookAuthor": &graphql.Field{
@@ -1488,11 +2675,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": bookAuthorFilter,
 					},
-					Resolve: batcher.GraphqlAll[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["book_author"].(map[string]any)
-						sql, args := sqlgen.Update("book_author", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "book_id,author_id")
+						sql, args, err := sqlgen.Update(bookAuthorTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(bookAuthorTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"book_id","author_id"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteBookAuthor": &graphql.Field{
@@ -1500,21 +2694,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": bookAuthorFilter,
 					},
-					Resolve: batcher.GraphqlAll[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from book_author where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "book_id,author_id")
+					Resolve: batcher.GraphqlAll[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(bookAuthorTable, `delete from "public"."book_author" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"book_id","author_id"`)
+						return sql, args, nil
 					}),
 				},
 				"createBookLanguage": &graphql.Field{
 					Type: bookLanguageType,
 					Args: graphql.FieldConfigArgument{
 						"book_language": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(bookLanguageInput),
+							Type: graphql.NewNonNull(bookLanguageInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["book_language"].(map[string]any)
-						return "insert into book_language(language_id,language_code,language_name)values($1,$2,$3)returning language_id,language_code,language_name", []any{set["language_id"], set["language_code"], set["language_name"]}
+						sql, args := sqlgen.Insert(bookLanguageTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"language_id","language_code","language_name"`)
+						return sql, args, nil
 					}),
 				},
 				"updateBookLanguage": &graphql.Field{
@@ -1525,11 +2725,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": bookLanguageFilter,
 					},
-					Resolve: batcher.GraphqlAll[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["book_language"].(map[string]any)
-						sql, args := sqlgen.Update("book_language", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "language_id,language_code,language_name")
+						sql, args, err := sqlgen.Update(bookLanguageTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(bookLanguageTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"language_id","language_code","language_name"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteBookLanguage": &graphql.Field{
@@ -1537,21 +2744,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": bookLanguageFilter,
 					},
-					Resolve: batcher.GraphqlAll[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from book_language where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "language_id,language_code,language_name")
+					Resolve: batcher.GraphqlAll[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(bookLanguageTable, `delete from "public"."book_language" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"language_id","language_code","language_name"`)
+						return sql, args, nil
 					}),
 				},
 				"createCountry": &graphql.Field{
 					Type: countryType,
 					Args: graphql.FieldConfigArgument{
 						"country": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(countryInput),
+							Type: graphql.NewNonNull(countryInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*Country](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*Country](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["country"].(map[string]any)
-						return "insert into country(country_id,country_name)values($1,$2)returning country_id,country_name", []any{set["country_id"], set["country_name"]}
+						sql, args := sqlgen.Insert(countryTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"country_id","country_name"`)
+						return sql, args, nil
 					}),
 				},
 				"updateCountry": &graphql.Field{
@@ -1562,11 +2775,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": countryFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Country](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*Country](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["country"].(map[string]any)
-						sql, args := sqlgen.Update("country", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "country_id,country_name")
+						sql, args, err := sqlgen.Update(countryTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(countryTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"country_id","country_name"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteCountry": &graphql.Field{
@@ -1574,21 +2794,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": countryFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Country](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from country where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "country_id,country_name")
+					Resolve: batcher.GraphqlAll[*Country](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(countryTable, `delete from "public"."country" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"country_id","country_name"`)
+						return sql, args, nil
 					}),
 				},
 				"createCustOrder": &graphql.Field{
 					Type: custOrderType,
 					Args: graphql.FieldConfigArgument{
 						"cust_order": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(custOrderInput),
+							Type: graphql.NewNonNull(custOrderInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["cust_order"].(map[string]any)
-						return "insert into cust_order(order_id,order_date,customer_id,shipping_method_id,dest_address_id)values($1,$2,$3,$4,$5)returning order_id,order_date,customer_id,shipping_method_id,dest_address_id", []any{set["order_id"], set["order_date"], set["customer_id"], set["shipping_method_id"], set["dest_address_id"]}
+						sql, args := sqlgen.Insert(custOrderTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"order_id","order_date","customer_id","shipping_method_id","dest_address_id"`)
+						return sql, args, nil
 					}),
 				},
 				"updateCustOrder": &graphql.Field{
@@ -1599,11 +2825,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": custOrderFilter,
 					},
-					Resolve: batcher.GraphqlAll[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["cust_order"].(map[string]any)
-						sql, args := sqlgen.Update("cust_order", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "order_id,order_date,customer_id,shipping_method_id,dest_address_id")
+						sql, args, err := sqlgen.Update(custOrderTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(custOrderTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"order_id","order_date","customer_id","shipping_method_id","dest_address_id"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteCustOrder": &graphql.Field{
@@ -1611,21 +2844,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": custOrderFilter,
 					},
-					Resolve: batcher.GraphqlAll[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from cust_order where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "order_id,order_date,customer_id,shipping_method_id,dest_address_id")
+					Resolve: batcher.GraphqlAll[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(custOrderTable, `delete from "public"."cust_order" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"order_id","order_date","customer_id","shipping_method_id","dest_address_id"`)
+						return sql, args, nil
 					}),
 				},
 				"createCustomer": &graphql.Field{
 					Type: customerType,
 					Args: graphql.FieldConfigArgument{
 						"customer": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(customerInput),
+							Type: graphql.NewNonNull(customerInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*Customer](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*Customer](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["customer"].(map[string]any)
-						return "insert into customer(customer_id,first_name,last_name,email)values($1,$2,$3,$4)returning customer_id,first_name,last_name,email", []any{set["customer_id"], set["first_name"], set["last_name"], set["email"]}
+						sql, args := sqlgen.Insert(customerTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"customer_id","first_name","last_name","email"`)
+						return sql, args, nil
 					}),
 				},
 				"updateCustomer": &graphql.Field{
@@ -1636,11 +2875,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": customerFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Customer](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*Customer](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["customer"].(map[string]any)
-						sql, args := sqlgen.Update("customer", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "customer_id,first_name,last_name,email")
+						sql, args, err := sqlgen.Update(customerTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(customerTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"customer_id","first_name","last_name","email"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteCustomer": &graphql.Field{
@@ -1648,21 +2894,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": customerFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Customer](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from customer where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "customer_id,first_name,last_name,email")
+					Resolve: batcher.GraphqlAll[*Customer](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(customerTable, `delete from "public"."customer" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"customer_id","first_name","last_name","email"`)
+						return sql, args, nil
 					}),
 				},
 				"createCustomerAddress": &graphql.Field{
 					Type: customerAddressType,
 					Args: graphql.FieldConfigArgument{
 						"customer_address": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(customerAddressInput),
+							Type: graphql.NewNonNull(customerAddressInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["customer_address"].(map[string]any)
-						return "insert into customer_address(customer_id,address_id,status_id)values($1,$2,$3)returning customer_id,address_id,status_id", []any{set["customer_id"], set["address_id"], set["status_id"]}
+						sql, args := sqlgen.Insert(customerAddressTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"customer_id","address_id","status_id"`)
+						return sql, args, nil
 					}),
 				},
 				"updateCustomerAddress": &graphql.Field{
@@ -1673,11 +2925,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": customerAddressFilter,
 					},
-					Resolve: batcher.GraphqlAll[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["customer_address"].(map[string]any)
-						sql, args := sqlgen.Update("customer_address", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "customer_id,address_id,status_id")
+						sql, args, err := sqlgen.Update(customerAddressTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(customerAddressTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"customer_id","address_id","status_id"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteCustomerAddress": &graphql.Field{
@@ -1685,21 +2944,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": customerAddressFilter,
 					},
-					Resolve: batcher.GraphqlAll[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from customer_address where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "customer_id,address_id,status_id")
+					Resolve: batcher.GraphqlAll[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(customerAddressTable, `delete from "public"."customer_address" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"customer_id","address_id","status_id"`)
+						return sql, args, nil
 					}),
 				},
 				"createOrderHistory": &graphql.Field{
 					Type: orderHistoryType,
 					Args: graphql.FieldConfigArgument{
 						"order_history": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(orderHistoryInput),
+							Type: graphql.NewNonNull(orderHistoryInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["order_history"].(map[string]any)
-						return "insert into order_history(history_id,order_id,status_id,status_date)values($1,$2,$3,$4)returning history_id,order_id,status_id,status_date", []any{set["history_id"], set["order_id"], set["status_id"], set["status_date"]}
+						sql, args := sqlgen.Insert(orderHistoryTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"history_id","order_id","status_id","status_date"`)
+						return sql, args, nil
 					}),
 				},
 				"updateOrderHistory": &graphql.Field{
@@ -1710,11 +2975,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": orderHistoryFilter,
 					},
-					Resolve: batcher.GraphqlAll[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["order_history"].(map[string]any)
-						sql, args := sqlgen.Update("order_history", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "history_id,order_id,status_id,status_date")
+						sql, args, err := sqlgen.Update(orderHistoryTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(orderHistoryTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"history_id","order_id","status_id","status_date"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteOrderHistory": &graphql.Field{
@@ -1722,21 +2994,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": orderHistoryFilter,
 					},
-					Resolve: batcher.GraphqlAll[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from order_history where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "history_id,order_id,status_id,status_date")
+					Resolve: batcher.GraphqlAll[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(orderHistoryTable, `delete from "public"."order_history" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"history_id","order_id","status_id","status_date"`)
+						return sql, args, nil
 					}),
 				},
 				"createOrderLine": &graphql.Field{
 					Type: orderLineType,
 					Args: graphql.FieldConfigArgument{
 						"order_line": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(orderLineInput),
+							Type: graphql.NewNonNull(orderLineInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["order_line"].(map[string]any)
-						return "insert into order_line(line_id,order_id,book_id,price)values($1,$2,$3,$4)returning line_id,order_id,book_id,price", []any{set["line_id"], set["order_id"], set["book_id"], set["price"]}
+						sql, args := sqlgen.Insert(orderLineTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"line_id","order_id","book_id","price"`)
+						return sql, args, nil
 					}),
 				},
 				"updateOrderLine": &graphql.Field{
@@ -1747,11 +3025,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": orderLineFilter,
 					},
-					Resolve: batcher.GraphqlAll[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["order_line"].(map[string]any)
-						sql, args := sqlgen.Update("order_line", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "line_id,order_id,book_id,price")
+						sql, args, err := sqlgen.Update(orderLineTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(orderLineTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"line_id","order_id","book_id","price"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteOrderLine": &graphql.Field{
@@ -1759,21 +3044,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": orderLineFilter,
 					},
-					Resolve: batcher.GraphqlAll[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from order_line where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "line_id,order_id,book_id,price")
+					Resolve: batcher.GraphqlAll[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(orderLineTable, `delete from "public"."order_line" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"line_id","order_id","book_id","price"`)
+						return sql, args, nil
 					}),
 				},
 				"createOrderStatus": &graphql.Field{
 					Type: orderStatusType,
 					Args: graphql.FieldConfigArgument{
 						"order_status": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(orderStatusInput),
+							Type: graphql.NewNonNull(orderStatusInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["order_status"].(map[string]any)
-						return "insert into order_status(status_id,status_value)values($1,$2)returning status_id,status_value", []any{set["status_id"], set["status_value"]}
+						sql, args := sqlgen.Insert(orderStatusTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"status_id","status_value"`)
+						return sql, args, nil
 					}),
 				},
 				"updateOrderStatus": &graphql.Field{
@@ -1784,11 +3075,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": orderStatusFilter,
 					},
-					Resolve: batcher.GraphqlAll[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["order_status"].(map[string]any)
-						sql, args := sqlgen.Update("order_status", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "status_id,status_value")
+						sql, args, err := sqlgen.Update(orderStatusTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(orderStatusTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"status_id","status_value"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteOrderStatus": &graphql.Field{
@@ -1796,21 +3094,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": orderStatusFilter,
 					},
-					Resolve: batcher.GraphqlAll[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from order_status where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "status_id,status_value")
+					Resolve: batcher.GraphqlAll[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(orderStatusTable, `delete from "public"."order_status" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"status_id","status_value"`)
+						return sql, args, nil
 					}),
 				},
 				"createPublisher": &graphql.Field{
 					Type: publisherType,
 					Args: graphql.FieldConfigArgument{
 						"publisher": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(publisherInput),
+							Type: graphql.NewNonNull(publisherInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*Publisher](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*Publisher](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["publisher"].(map[string]any)
-						return "insert into publisher(publisher_id,publisher_name)values($1,$2)returning publisher_id,publisher_name", []any{set["publisher_id"], set["publisher_name"]}
+						sql, args := sqlgen.Insert(publisherTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"publisher_id","publisher_name"`)
+						return sql, args, nil
 					}),
 				},
 				"updatePublisher": &graphql.Field{
@@ -1821,11 +3125,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": publisherFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Publisher](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*Publisher](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["publisher"].(map[string]any)
-						sql, args := sqlgen.Update("publisher", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "publisher_id,publisher_name")
+						sql, args, err := sqlgen.Update(publisherTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(publisherTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"publisher_id","publisher_name"`)
+						return sql, args, nil
 					}),
 				},
 				"deletePublisher": &graphql.Field{
@@ -1833,21 +3144,27 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": publisherFilter,
 					},
-					Resolve: batcher.GraphqlAll[*Publisher](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from publisher where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "publisher_id,publisher_name")
+					Resolve: batcher.GraphqlAll[*Publisher](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(publisherTable, `delete from "public"."publisher" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"publisher_id","publisher_name"`)
+						return sql, args, nil
 					}),
 				},
 				"createShippingMethod": &graphql.Field{
 					Type: shippingMethodType,
 					Args: graphql.FieldConfigArgument{
 						"shipping_method": &graphql.ArgumentConfig{
-							Type: graphql.NewNonNull(shippingMethodInput),
+							Type: graphql.NewNonNull(shippingMethodInsertInput),
 						},
 					},
-					Resolve: batcher.GraphqlOne[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlOne[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["shipping_method"].(map[string]any)
-						return "insert into shipping_method(method_id,method_name,cost)values($1,$2,$3)returning method_id,method_name,cost", []any{set["method_id"], set["method_name"], set["cost"]}
+						sql, args := sqlgen.Insert(shippingMethodTable, set)
+						sql, args = sqlgen.Returning(sql, args, `"method_id","method_name","cost"`)
+						return sql, args, nil
 					}),
 				},
 				"updateShippingMethod": &graphql.Field{
@@ -1858,11 +3175,18 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 						},
 						"filter": shippingMethodFilter,
 					},
-					Resolve: batcher.GraphqlAll[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any) {
+					Resolve: batcher.GraphqlAll[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any, error) {
 						set := p.Args["shipping_method"].(map[string]any)
-						sql, args := sqlgen.Update("shipping_method", set)
-						sql, args = filter.SQL(sql, args, p)
-						return sqlgen.Returning(sql, args, "method_id,method_name,cost")
+						sql, args, err := sqlgen.Update(shippingMethodTable, set)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args, err = filter.SQL(shippingMethodTable, sql, args, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"method_id","method_name","cost"`)
+						return sql, args, nil
 					}),
 				},
 				"deleteShippingMethod": &graphql.Field{
@@ -1870,9 +3194,13 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 					Args: graphql.FieldConfigArgument{
 						"filter": shippingMethodFilter,
 					},
-					Resolve: batcher.GraphqlAll[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any) {
-						sql, args := filter.SQL("delete from shipping_method where 1=1", nil, p)
-						return sqlgen.Returning(sql, args, "method_id,method_name,cost")
+					Resolve: batcher.GraphqlAll[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						sql, args, err := filter.SQL(shippingMethodTable, `delete from "public"."shipping_method" where 1=1`, nil, p)
+						if err != nil {
+							return "", nil, err
+						}
+						sql, args = sqlgen.Returning(sql, args, `"method_id","method_name","cost"`)
+						return sql, args, nil
 					}),
 				},
 			},
@@ -1882,109 +3210,740 @@ func NewSchemaConfig(pq pgxscan.Queryer) graphql.SchemaConfig {
 			Fields: graphql.Fields{
 				"address": &graphql.Field{
 					Type: graphql.NewList(addressType),
-					Args: filter.NewCursorInput(addressFilter),
-					Resolve: batcher.GraphqlAll[*Address](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select address_id,street_number,street_name,city,country_id from address where 1=1", nil, p)
+					Args: filter.NewCursorInput(addressFilter, addressOrderBy),
+					Resolve: batcher.GraphqlAll[*Address](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(addressTable, `select "address_id","street_number","street_name","city","country_id" from "public"."address" where 1=1`, nil, p)
 					}),
 				},
 				"address_status": &graphql.Field{
 					Type: graphql.NewList(addressStatusType),
-					Args: filter.NewCursorInput(addressStatusFilter),
-					Resolve: batcher.GraphqlAll[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select status_id,address_status from address_status where 1=1", nil, p)
+					Args: filter.NewCursorInput(addressStatusFilter, addressStatusOrderBy),
+					Resolve: batcher.GraphqlAll[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(addressStatusTable, `select "status_id","address_status" from "public"."address_status" where 1=1`, nil, p)
 					}),
 				},
 				"author": &graphql.Field{
 					Type: graphql.NewList(authorType),
-					Args: filter.NewCursorInput(authorFilter),
-					Resolve: batcher.GraphqlAll[*Author](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select author_id,author_name from author where 1=1", nil, p)
+					Args: filter.NewCursorInput(authorFilter, authorOrderBy),
+					Resolve: batcher.GraphqlAll[*Author](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(authorTable, `select "author_id","author_name" from "public"."author" where 1=1`, nil, p)
 					}),
 				},
 				"book": &graphql.Field{
 					Type: graphql.NewList(bookType),
-					Args: filter.NewCursorInput(bookFilter),
-					Resolve: batcher.GraphqlAll[*Book](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select book_id,title,isbn13,language_id,num_pages,publication_date,publisher_id from book where 1=1", nil, p)
+					Args: filter.NewCursorInput(bookFilter, bookOrderBy),
+					Resolve: batcher.GraphqlAll[*Book](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(bookTable, `select "book_id","title","isbn13","language_id","num_pages","publication_date","publisher_id" from "public"."book" where 1=1`, nil, p)
 					}),
 				},
 				"book_author": &graphql.Field{
 					Type: graphql.NewList(bookAuthorType),
-					Args: filter.NewCursorInput(bookAuthorFilter),
-					Resolve: batcher.GraphqlAll[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select book_id,author_id from book_author where 1=1", nil, p)
+					Args: filter.NewCursorInput(bookAuthorFilter, bookAuthorOrderBy),
+					Resolve: batcher.GraphqlAll[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(bookAuthorTable, `select "book_id","author_id" from "public"."book_author" where 1=1`, nil, p)
 					}),
 				},
 				"book_language": &graphql.Field{
 					Type: graphql.NewList(bookLanguageType),
-					Args: filter.NewCursorInput(bookLanguageFilter),
-					Resolve: batcher.GraphqlAll[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select language_id,language_code,language_name from book_language where 1=1", nil, p)
+					Args: filter.NewCursorInput(bookLanguageFilter, bookLanguageOrderBy),
+					Resolve: batcher.GraphqlAll[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(bookLanguageTable, `select "language_id","language_code","language_name" from "public"."book_language" where 1=1`, nil, p)
 					}),
 				},
 				"country": &graphql.Field{
 					Type: graphql.NewList(countryType),
-					Args: filter.NewCursorInput(countryFilter),
-					Resolve: batcher.GraphqlAll[*Country](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select country_id,country_name from country where 1=1", nil, p)
+					Args: filter.NewCursorInput(countryFilter, countryOrderBy),
+					Resolve: batcher.GraphqlAll[*Country](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(countryTable, `select "country_id","country_name" from "public"."country" where 1=1`, nil, p)
 					}),
 				},
 				"cust_order": &graphql.Field{
 					Type: graphql.NewList(custOrderType),
-					Args: filter.NewCursorInput(custOrderFilter),
-					Resolve: batcher.GraphqlAll[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select order_id,order_date,customer_id,shipping_method_id,dest_address_id from cust_order where 1=1", nil, p)
+					Args: filter.NewCursorInput(custOrderFilter, custOrderOrderBy),
+					Resolve: batcher.GraphqlAll[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(custOrderTable, `select "order_id","order_date","customer_id","shipping_method_id","dest_address_id" from "public"."cust_order" where 1=1`, nil, p)
 					}),
 				},
 				"customer": &graphql.Field{
 					Type: graphql.NewList(customerType),
-					Args: filter.NewCursorInput(customerFilter),
-					Resolve: batcher.GraphqlAll[*Customer](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select customer_id,first_name,last_name,email from customer where 1=1", nil, p)
+					Args: filter.NewCursorInput(customerFilter, customerOrderBy),
+					Resolve: batcher.GraphqlAll[*Customer](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(customerTable, `select "customer_id","first_name","last_name","email" from "public"."customer" where 1=1`, nil, p)
 					}),
 				},
 				"customer_address": &graphql.Field{
 					Type: graphql.NewList(customerAddressType),
-					Args: filter.NewCursorInput(customerAddressFilter),
-					Resolve: batcher.GraphqlAll[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select customer_id,address_id,status_id from customer_address where 1=1", nil, p)
+					Args: filter.NewCursorInput(customerAddressFilter, customerAddressOrderBy),
+					Resolve: batcher.GraphqlAll[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(customerAddressTable, `select "customer_id","address_id","status_id" from "public"."customer_address" where 1=1`, nil, p)
 					}),
 				},
 				"order_history": &graphql.Field{
 					Type: graphql.NewList(orderHistoryType),
-					Args: filter.NewCursorInput(orderHistoryFilter),
-					Resolve: batcher.GraphqlAll[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select history_id,order_id,status_id,status_date from order_history where 1=1", nil, p)
+					Args: filter.NewCursorInput(orderHistoryFilter, orderHistoryOrderBy),
+					Resolve: batcher.GraphqlAll[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(orderHistoryTable, `select "history_id","order_id","status_id","status_date" from "public"."order_history" where 1=1`, nil, p)
 					}),
 				},
 				"order_line": &graphql.Field{
 					Type: graphql.NewList(orderLineType),
-					Args: filter.NewCursorInput(orderLineFilter),
-					Resolve: batcher.GraphqlAll[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select line_id,order_id,book_id,price from order_line where 1=1", nil, p)
+					Args: filter.NewCursorInput(orderLineFilter, orderLineOrderBy),
+					Resolve: batcher.GraphqlAll[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(orderLineTable, `select "line_id","order_id","book_id","price" from "public"."order_line" where 1=1`, nil, p)
 					}),
 				},
 				"order_status": &graphql.Field{
 					Type: graphql.NewList(orderStatusType),
-					Args: filter.NewCursorInput(orderStatusFilter),
-					Resolve: batcher.GraphqlAll[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select status_id,status_value from order_status where 1=1", nil, p)
+					Args: filter.NewCursorInput(orderStatusFilter, orderStatusOrderBy),
+					Resolve: batcher.GraphqlAll[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(orderStatusTable, `select "status_id","status_value" from "public"."order_status" where 1=1`, nil, p)
 					}),
 				},
 				"publisher": &graphql.Field{
 					Type: graphql.NewList(publisherType),
-					Args: filter.NewCursorInput(publisherFilter),
-					Resolve: batcher.GraphqlAll[*Publisher](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select publisher_id,publisher_name from publisher where 1=1", nil, p)
+					Args: filter.NewCursorInput(publisherFilter, publisherOrderBy),
+					Resolve: batcher.GraphqlAll[*Publisher](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(publisherTable, `select "publisher_id","publisher_name" from "public"."publisher" where 1=1`, nil, p)
 					}),
 				},
 				"shipping_method": &graphql.Field{
 					Type: graphql.NewList(shippingMethodType),
-					Args: filter.NewCursorInput(shippingMethodFilter),
-					Resolve: batcher.GraphqlAll[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any) {
-						return filter.SQL("select method_id,method_name,cost from shipping_method where 1=1", nil, p)
+					Args: filter.NewCursorInput(shippingMethodFilter, shippingMethodOrderBy),
+					Resolve: batcher.GraphqlAll[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(shippingMethodTable, `select "method_id","method_name","cost" from "public"."shipping_method" where 1=1`, nil, p)
+					}),
+				},
+				"address_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(addressAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": addressFilter,
+					},
+					Resolve: batcher.GraphqlOne[*AddressAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(addressTable, `select count(*) as "count",min("address_id") as "min.address_id",min("street_number") as "min.street_number",min("street_name") as "min.street_name",min("city") as "min.city",min("country_id") as "min.country_id",max("address_id") as "max.address_id",max("street_number") as "max.street_number",max("street_name") as "max.street_name",max("city") as "max.city",max("country_id") as "max.country_id" from "public"."address" where 1=1`, nil, p)
+					}),
+				},
+				"address_status_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(addressStatusAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": addressStatusFilter,
+					},
+					Resolve: batcher.GraphqlOne[*AddressStatusAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(addressStatusTable, `select count(*) as "count",min("status_id") as "min.status_id",min("address_status") as "min.address_status",max("status_id") as "max.status_id",max("address_status") as "max.address_status" from "public"."address_status" where 1=1`, nil, p)
+					}),
+				},
+				"author_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(authorAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": authorFilter,
+					},
+					Resolve: batcher.GraphqlOne[*AuthorAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(authorTable, `select count(*) as "count",min("author_id") as "min.author_id",min("author_name") as "min.author_name",max("author_id") as "max.author_id",max("author_name") as "max.author_name" from "public"."author" where 1=1`, nil, p)
+					}),
+				},
+				"book_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(bookAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": bookFilter,
+					},
+					Resolve: batcher.GraphqlOne[*BookAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(bookTable, `select count(*) as "count",sum("num_pages") as "sum.num_pages",avg("num_pages") as "avg.num_pages",min("book_id") as "min.book_id",min("title") as "min.title",min("isbn13") as "min.isbn13",min("language_id") as "min.language_id",min("num_pages") as "min.num_pages",min("publication_date") as "min.publication_date",min("publisher_id") as "min.publisher_id",max("book_id") as "max.book_id",max("title") as "max.title",max("isbn13") as "max.isbn13",max("language_id") as "max.language_id",max("num_pages") as "max.num_pages",max("publication_date") as "max.publication_date",max("publisher_id") as "max.publisher_id" from "public"."book" where 1=1`, nil, p)
+					}),
+				},
+				"book_author_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(bookAuthorAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": bookAuthorFilter,
+					},
+					Resolve: batcher.GraphqlOne[*BookAuthorAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(bookAuthorTable, `select count(*) as "count",min("book_id") as "min.book_id",min("author_id") as "min.author_id",max("book_id") as "max.book_id",max("author_id") as "max.author_id" from "public"."book_author" where 1=1`, nil, p)
+					}),
+				},
+				"book_language_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(bookLanguageAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": bookLanguageFilter,
+					},
+					Resolve: batcher.GraphqlOne[*BookLanguageAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(bookLanguageTable, `select count(*) as "count",min("language_id") as "min.language_id",min("language_code") as "min.language_code",min("language_name") as "min.language_name",max("language_id") as "max.language_id",max("language_code") as "max.language_code",max("language_name") as "max.language_name" from "public"."book_language" where 1=1`, nil, p)
+					}),
+				},
+				"country_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(countryAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": countryFilter,
+					},
+					Resolve: batcher.GraphqlOne[*CountryAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(countryTable, `select count(*) as "count",min("country_id") as "min.country_id",min("country_name") as "min.country_name",max("country_id") as "max.country_id",max("country_name") as "max.country_name" from "public"."country" where 1=1`, nil, p)
+					}),
+				},
+				"cust_order_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(custOrderAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": custOrderFilter,
+					},
+					Resolve: batcher.GraphqlOne[*CustOrderAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(custOrderTable, `select count(*) as "count",min("order_id") as "min.order_id",min("order_date") as "min.order_date",min("customer_id") as "min.customer_id",min("shipping_method_id") as "min.shipping_method_id",min("dest_address_id") as "min.dest_address_id",max("order_id") as "max.order_id",max("order_date") as "max.order_date",max("customer_id") as "max.customer_id",max("shipping_method_id") as "max.shipping_method_id",max("dest_address_id") as "max.dest_address_id" from "public"."cust_order" where 1=1`, nil, p)
+					}),
+				},
+				"customer_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(customerAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": customerFilter,
+					},
+					Resolve: batcher.GraphqlOne[*CustomerAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(customerTable, `select count(*) as "count",min("customer_id") as "min.customer_id",min("first_name") as "min.first_name",min("last_name") as "min.last_name",min("email") as "min.email",max("customer_id") as "max.customer_id",max("first_name") as "max.first_name",max("last_name") as "max.last_name",max("email") as "max.email" from "public"."customer" where 1=1`, nil, p)
+					}),
+				},
+				"customer_address_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(customerAddressAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": customerAddressFilter,
+					},
+					Resolve: batcher.GraphqlOne[*CustomerAddressAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(customerAddressTable, `select count(*) as "count",sum("status_id") as "sum.status_id",avg("status_id") as "avg.status_id",min("customer_id") as "min.customer_id",min("address_id") as "min.address_id",min("status_id") as "min.status_id",max("customer_id") as "max.customer_id",max("address_id") as "max.address_id",max("status_id") as "max.status_id" from "public"."customer_address" where 1=1`, nil, p)
+					}),
+				},
+				"order_history_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(orderHistoryAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": orderHistoryFilter,
+					},
+					Resolve: batcher.GraphqlOne[*OrderHistoryAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(orderHistoryTable, `select count(*) as "count",min("history_id") as "min.history_id",min("order_id") as "min.order_id",min("status_id") as "min.status_id",min("status_date") as "min.status_date",max("history_id") as "max.history_id",max("order_id") as "max.order_id",max("status_id") as "max.status_id",max("status_date") as "max.status_date" from "public"."order_history" where 1=1`, nil, p)
 					}),
 				},
+				"order_line_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(orderLineAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": orderLineFilter,
+					},
+					Resolve: batcher.GraphqlOne[*OrderLineAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(orderLineTable, `select count(*) as "count",sum("price") as "sum.price",avg("price") as "avg.price",min("line_id") as "min.line_id",min("order_id") as "min.order_id",min("book_id") as "min.book_id",min("price") as "min.price",max("line_id") as "max.line_id",max("order_id") as "max.order_id",max("book_id") as "max.book_id",max("price") as "max.price" from "public"."order_line" where 1=1`, nil, p)
+					}),
+				},
+				"order_status_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(orderStatusAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": orderStatusFilter,
+					},
+					Resolve: batcher.GraphqlOne[*OrderStatusAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(orderStatusTable, `select count(*) as "count",min("status_id") as "min.status_id",min("status_value") as "min.status_value",max("status_id") as "max.status_id",max("status_value") as "max.status_value" from "public"."order_status" where 1=1`, nil, p)
+					}),
+				},
+				"publisher_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(publisherAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": publisherFilter,
+					},
+					Resolve: batcher.GraphqlOne[*PublisherAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(publisherTable, `select count(*) as "count",min("publisher_id") as "min.publisher_id",min("publisher_name") as "min.publisher_name",max("publisher_id") as "max.publisher_id",max("publisher_name") as "max.publisher_name" from "public"."publisher" where 1=1`, nil, p)
+					}),
+				},
+				"shipping_method_aggregate": &graphql.Field{
+					Type: graphql.NewNonNull(shippingMethodAggregateType),
+					Args: graphql.FieldConfigArgument{
+						"filter": shippingMethodFilter,
+					},
+					Resolve: batcher.GraphqlOne[*ShippingMethodAggregate](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return filter.SQL(shippingMethodTable, `select count(*) as "count",sum("cost") as "sum.cost",avg("cost") as "avg.cost",min("method_id") as "min.method_id",min("method_name") as "min.method_name",min("cost") as "min.cost",max("method_id") as "max.method_id",max("method_name") as "max.method_name",max("cost") as "max.cost" from "public"."shipping_method" where 1=1`, nil, p)
+					}),
+				},
+				"address_by_pk": &graphql.Field{
+					Type: addressType,
+					Args: graphql.FieldConfigArgument{
+						"address_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*Address](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "address_id","street_number","street_name","city","country_id" from "public"."address" where 1=1 and "address_id"=$1`, []any{p.Args["address_id"]}, nil
+					}),
+				},
+				"address_status_by_pk": &graphql.Field{
+					Type: addressStatusType,
+					Args: graphql.FieldConfigArgument{
+						"status_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*AddressStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "status_id","address_status" from "public"."address_status" where 1=1 and "status_id"=$1`, []any{p.Args["status_id"]}, nil
+					}),
+				},
+				"author_by_pk": &graphql.Field{
+					Type: authorType,
+					Args: graphql.FieldConfigArgument{
+						"author_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*Author](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "author_id","author_name" from "public"."author" where 1=1 and "author_id"=$1`, []any{p.Args["author_id"]}, nil
+					}),
+				},
+				"book_by_pk": &graphql.Field{
+					Type: bookType,
+					Args: graphql.FieldConfigArgument{
+						"book_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*Book](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "book_id","title","isbn13","language_id","num_pages","publication_date","publisher_id" from "public"."book" where 1=1 and "book_id"=$1`, []any{p.Args["book_id"]}, nil
+					}),
+				},
+				"book_author_by_pk": &graphql.Field{
+					Type: bookAuthorType,
+					Args: graphql.FieldConfigArgument{
+						"book_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+						"author_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*BookAuthor](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "book_id","author_id" from "public"."book_author" where 1=1 and "book_id"=$1 and "author_id"=$2`, []any{p.Args["book_id"], p.Args["author_id"]}, nil
+					}),
+				},
+				"book_language_by_pk": &graphql.Field{
+					Type: bookLanguageType,
+					Args: graphql.FieldConfigArgument{
+						"language_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*BookLanguage](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "language_id","language_code","language_name" from "public"."book_language" where 1=1 and "language_id"=$1`, []any{p.Args["language_id"]}, nil
+					}),
+				},
+				"country_by_pk": &graphql.Field{
+					Type: countryType,
+					Args: graphql.FieldConfigArgument{
+						"country_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*Country](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "country_id","country_name" from "public"."country" where 1=1 and "country_id"=$1`, []any{p.Args["country_id"]}, nil
+					}),
+				},
+				"cust_order_by_pk": &graphql.Field{
+					Type: custOrderType,
+					Args: graphql.FieldConfigArgument{
+						"order_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*CustOrder](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "order_id","order_date","customer_id","shipping_method_id","dest_address_id" from "public"."cust_order" where 1=1 and "order_id"=$1`, []any{p.Args["order_id"]}, nil
+					}),
+				},
+				"customer_by_pk": &graphql.Field{
+					Type: customerType,
+					Args: graphql.FieldConfigArgument{
+						"customer_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*Customer](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "customer_id","first_name","last_name","email" from "public"."customer" where 1=1 and "customer_id"=$1`, []any{p.Args["customer_id"]}, nil
+					}),
+				},
+				"customer_address_by_pk": &graphql.Field{
+					Type: customerAddressType,
+					Args: graphql.FieldConfigArgument{
+						"customer_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+						"address_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*CustomerAddress](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "customer_id","address_id","status_id" from "public"."customer_address" where 1=1 and "customer_id"=$1 and "address_id"=$2`, []any{p.Args["customer_id"], p.Args["address_id"]}, nil
+					}),
+				},
+				"order_history_by_pk": &graphql.Field{
+					Type: orderHistoryType,
+					Args: graphql.FieldConfigArgument{
+						"history_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*OrderHistory](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "history_id","order_id","status_id","status_date" from "public"."order_history" where 1=1 and "history_id"=$1`, []any{p.Args["history_id"]}, nil
+					}),
+				},
+				"order_line_by_pk": &graphql.Field{
+					Type: orderLineType,
+					Args: graphql.FieldConfigArgument{
+						"line_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*OrderLine](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "line_id","order_id","book_id","price" from "public"."order_line" where 1=1 and "line_id"=$1`, []any{p.Args["line_id"]}, nil
+					}),
+				},
+				"order_status_by_pk": &graphql.Field{
+					Type: orderStatusType,
+					Args: graphql.FieldConfigArgument{
+						"status_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*OrderStatus](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "status_id","status_value" from "public"."order_status" where 1=1 and "status_id"=$1`, []any{p.Args["status_id"]}, nil
+					}),
+				},
+				"publisher_by_pk": &graphql.Field{
+					Type: publisherType,
+					Args: graphql.FieldConfigArgument{
+						"publisher_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*Publisher](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "publisher_id","publisher_name" from "public"."publisher" where 1=1 and "publisher_id"=$1`, []any{p.Args["publisher_id"]}, nil
+					}),
+				},
+				"shipping_method_by_pk": &graphql.Field{
+					Type: shippingMethodType,
+					Args: graphql.FieldConfigArgument{
+						"method_id": &graphql.ArgumentConfig{
+							Type: graphql.NewNonNull(graphql.Int),
+						},
+					},
+					Resolve: batcher.GraphqlOne[*ShippingMethod](pq, func(p graphql.ResolveParams) (string, []any, error) {
+						return `select "method_id","method_name","cost" from "public"."shipping_method" where 1=1 and "method_id"=$1`, []any{p.Args["method_id"]}, nil
+					}),
+				},
+				"address_connection": &graphql.Field{
+					Type: graphql.NewNonNull(addressConnection),
+					Args: filter.NewConnectionInput(addressFilter, addressOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						addressTable,
+						`select "address_id","street_number","street_name","city","country_id" from "public"."address" where 1=1`,
+						[]string{"address_id"},
+						func(v *Address, column string) any {
+							switch column {
+							case "address_id":
+								return v.AddressId
+							case "street_number":
+								return v.StreetNumber
+							case "street_name":
+								return v.StreetName
+							case "city":
+								return v.City
+							case "country_id":
+								return v.CountryId
+							}
+							return nil
+						},
+					),
+				},
+				"address_status_connection": &graphql.Field{
+					Type: graphql.NewNonNull(addressStatusConnection),
+					Args: filter.NewConnectionInput(addressStatusFilter, addressStatusOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						addressStatusTable,
+						`select "status_id","address_status" from "public"."address_status" where 1=1`,
+						[]string{"status_id"},
+						func(v *AddressStatus, column string) any {
+							switch column {
+							case "status_id":
+								return v.StatusId
+							case "address_status":
+								return v.AddressStatus
+							}
+							return nil
+						},
+					),
+				},
+				"author_connection": &graphql.Field{
+					Type: graphql.NewNonNull(authorConnection),
+					Args: filter.NewConnectionInput(authorFilter, authorOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						authorTable,
+						`select "author_id","author_name" from "public"."author" where 1=1`,
+						[]string{"author_id"},
+						func(v *Author, column string) any {
+							switch column {
+							case "author_id":
+								return v.AuthorId
+							case "author_name":
+								return v.AuthorName
+							}
+							return nil
+						},
+					),
+				},
+				"book_connection": &graphql.Field{
+					Type: graphql.NewNonNull(bookConnection),
+					Args: filter.NewConnectionInput(bookFilter, bookOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						bookTable,
+						`select "book_id","title","isbn13","language_id","num_pages","publication_date","publisher_id" from "public"."book" where 1=1`,
+						[]string{"book_id"},
+						func(v *Book, column string) any {
+							switch column {
+							case "book_id":
+								return v.BookId
+							case "title":
+								return v.Title
+							case "isbn13":
+								return v.Isbn13
+							case "language_id":
+								return v.LanguageId
+							case "num_pages":
+								return v.NumPages
+							case "publication_date":
+								return v.PublicationDate
+							case "publisher_id":
+								return v.PublisherId
+							}
+							return nil
+						},
+					),
+				},
+				"book_author_connection": &graphql.Field{
+					Type: graphql.NewNonNull(bookAuthorConnection),
+					Args: filter.NewConnectionInput(bookAuthorFilter, bookAuthorOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						bookAuthorTable,
+						`select "book_id","author_id" from "public"."book_author" where 1=1`,
+						[]string{"book_id", "author_id"},
+						func(v *BookAuthor, column string) any {
+							switch column {
+							case "book_id":
+								return v.BookId
+							case "author_id":
+								return v.AuthorId
+							}
+							return nil
+						},
+					),
+				},
+				"book_language_connection": &graphql.Field{
+					Type: graphql.NewNonNull(bookLanguageConnection),
+					Args: filter.NewConnectionInput(bookLanguageFilter, bookLanguageOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						bookLanguageTable,
+						`select "language_id","language_code","language_name" from "public"."book_language" where 1=1`,
+						[]string{"language_id"},
+						func(v *BookLanguage, column string) any {
+							switch column {
+							case "language_id":
+								return v.LanguageId
+							case "language_code":
+								return v.LanguageCode
+							case "language_name":
+								return v.LanguageName
+							}
+							return nil
+						},
+					),
+				},
+				"country_connection": &graphql.Field{
+					Type: graphql.NewNonNull(countryConnection),
+					Args: filter.NewConnectionInput(countryFilter, countryOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						countryTable,
+						`select "country_id","country_name" from "public"."country" where 1=1`,
+						[]string{"country_id"},
+						func(v *Country, column string) any {
+							switch column {
+							case "country_id":
+								return v.CountryId
+							case "country_name":
+								return v.CountryName
+							}
+							return nil
+						},
+					),
+				},
+				"cust_order_connection": &graphql.Field{
+					Type: graphql.NewNonNull(custOrderConnection),
+					Args: filter.NewConnectionInput(custOrderFilter, custOrderOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						custOrderTable,
+						`select "order_id","order_date","customer_id","shipping_method_id","dest_address_id" from "public"."cust_order" where 1=1`,
+						[]string{"order_id"},
+						func(v *CustOrder, column string) any {
+							switch column {
+							case "order_id":
+								return v.OrderId
+							case "order_date":
+								return v.OrderDate
+							case "customer_id":
+								return v.CustomerId
+							case "shipping_method_id":
+								return v.ShippingMethodId
+							case "dest_address_id":
+								return v.DestAddressId
+							}
+							return nil
+						},
+					),
+				},
+				"customer_connection": &graphql.Field{
+					Type: graphql.NewNonNull(customerConnection),
+					Args: filter.NewConnectionInput(customerFilter, customerOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						customerTable,
+						`select "customer_id","first_name","last_name","email" from "public"."customer" where 1=1`,
+						[]string{"customer_id"},
+						func(v *Customer, column string) any {
+							switch column {
+							case "customer_id":
+								return v.CustomerId
+							case "first_name":
+								return v.FirstName
+							case "last_name":
+								return v.LastName
+							case "email":
+								return v.Email
+							}
+							return nil
+						},
+					),
+				},
+				"customer_address_connection": &graphql.Field{
+					Type: graphql.NewNonNull(customerAddressConnection),
+					Args: filter.NewConnectionInput(customerAddressFilter, customerAddressOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						customerAddressTable,
+						`select "customer_id","address_id","status_id" from "public"."customer_address" where 1=1`,
+						[]string{"customer_id", "address_id"},
+						func(v *CustomerAddress, column string) any {
+							switch column {
+							case "customer_id":
+								return v.CustomerId
+							case "address_id":
+								return v.AddressId
+							case "status_id":
+								return v.StatusId
+							}
+							return nil
+						},
+					),
+				},
+				"order_history_connection": &graphql.Field{
+					Type: graphql.NewNonNull(orderHistoryConnection),
+					Args: filter.NewConnectionInput(orderHistoryFilter, orderHistoryOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						orderHistoryTable,
+						`select "history_id","order_id","status_id","status_date" from "public"."order_history" where 1=1`,
+						[]string{"history_id"},
+						func(v *OrderHistory, column string) any {
+							switch column {
+							case "history_id":
+								return v.HistoryId
+							case "order_id":
+								return v.OrderId
+							case "status_id":
+								return v.StatusId
+							case "status_date":
+								return v.StatusDate
+							}
+							return nil
+						},
+					),
+				},
+				"order_line_connection": &graphql.Field{
+					Type: graphql.NewNonNull(orderLineConnection),
+					Args: filter.NewConnectionInput(orderLineFilter, orderLineOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						orderLineTable,
+						`select "line_id","order_id","book_id","price" from "public"."order_line" where 1=1`,
+						[]string{"line_id"},
+						func(v *OrderLine, column string) any {
+							switch column {
+							case "line_id":
+								return v.LineId
+							case "order_id":
+								return v.OrderId
+							case "book_id":
+								return v.BookId
+							case "price":
+								return v.Price
+							}
+							return nil
+						},
+					),
+				},
+				"order_status_connection": &graphql.Field{
+					Type: graphql.NewNonNull(orderStatusConnection),
+					Args: filter.NewConnectionInput(orderStatusFilter, orderStatusOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						orderStatusTable,
+						`select "status_id","status_value" from "public"."order_status" where 1=1`,
+						[]string{"status_id"},
+						func(v *OrderStatus, column string) any {
+							switch column {
+							case "status_id":
+								return v.StatusId
+							case "status_value":
+								return v.StatusValue
+							}
+							return nil
+						},
+					),
+				},
+				"publisher_connection": &graphql.Field{
+					Type: graphql.NewNonNull(publisherConnection),
+					Args: filter.NewConnectionInput(publisherFilter, publisherOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						publisherTable,
+						`select "publisher_id","publisher_name" from "public"."publisher" where 1=1`,
+						[]string{"publisher_id"},
+						func(v *Publisher, column string) any {
+							switch column {
+							case "publisher_id":
+								return v.PublisherId
+							case "publisher_name":
+								return v.PublisherName
+							}
+							return nil
+						},
+					),
+				},
+				"shipping_method_connection": &graphql.Field{
+					Type: graphql.NewNonNull(shippingMethodConnection),
+					Args: filter.NewConnectionInput(shippingMethodFilter, shippingMethodOrderBy),
+					Resolve: batcher.GraphqlConnection(
+						pq,
+						shippingMethodTable,
+						`select "method_id","method_name","cost" from "public"."shipping_method" where 1=1`,
+						[]string{"method_id"},
+						func(v *ShippingMethod, column string) any {
+							switch column {
+							case "method_id":
+								return v.MethodId
+							case "method_name":
+								return v.MethodName
+							case "cost":
+								return v.Cost
+							}
+							return nil
+						},
+					),
+				},
 			},
 		}),
 	}
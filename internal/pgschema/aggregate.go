@@ -0,0 +1,191 @@
+package pgschema
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/regeda/turboql/pkg/sqlgen"
+)
+
+// sumTypes and avgTypes map types of columns to result types of sum() and avg().
+var (
+	sumTypes = map[string]string{
+		"smallint":         "bigint",
+		"integer":          "bigint",
+		"bigint":           "numeric",
+		"real":             "real",
+		"double precision": "double precision",
+		"numeric":          "numeric",
+		"money":            "money",
+		"interval":         "interval",
+	}
+	avgTypes = map[string]string{
+		"smallint":         "numeric",
+		"integer":          "numeric",
+		"bigint":           "numeric",
+		"real":             "double precision",
+		"double precision": "double precision",
+		"numeric":          "numeric",
+		"interval":         "interval",
+	}
+	// minMaxTypes are types having min() and max(), enums have them as well.
+	minMaxTypes = map[string]bool{
+		"smallint":                    true,
+		"integer":                     true,
+		"bigint":                      true,
+		"real":                        true,
+		"double precision":            true,
+		"numeric":                     true,
+		"money":                       true,
+		"oid":                         true,
+		"text":                        true,
+		"character":                   true,
+		"character varying":           true,
+		"citext":                      true,
+		"date":                        true,
+		"time without time zone":      true,
+		"time with time zone":         true,
+		"timestamp without time zone": true,
+		"timestamp with time zone":    true,
+		"interval":                    true,
+		"inet":                        true,
+	}
+)
+
+// aggregateColumn returns the nullable result column of the aggregate of the type,
+// overridden types are computed only by min() and max() keeping the type of the column.
+func aggregateColumn(c Column, types map[string]string) (Column, bool) {
+	if c.Array() || c.Enum != nil || c.Override != nil {
+		return Column{}, false
+	}
+	typ, ok := types[c.Type]
+	if !ok {
+		return Column{}, false
+	}
+	return Column{Name: c.Name, Type: typ, Annotations: c.Annotations}, true
+}
+
+// keyColumn reports whether the column belongs to the primary key or a foreign key,
+// sums and averages of identifiers make no sense.
+func (t Table) keyColumn(c Column) bool {
+	for _, pk := range t.PrimaryKeys {
+		if slices.Contains(pk.Columns, c.Num) {
+			return true
+		}
+	}
+	for _, fk := range t.ForeignKeys {
+		if slices.Contains(fk.Columns, c.Num) {
+			return true
+		}
+	}
+	return false
+}
+
+// SumColumns returns result columns of sum() of fields, key columns are left out.
+func (t Table) SumColumns() []Column {
+	var cols []Column
+	for _, c := range t.Fields() {
+		if t.keyColumn(c) {
+			continue
+		}
+		if r, ok := aggregateColumn(c, sumTypes); ok {
+			cols = append(cols, r)
+		}
+	}
+	return cols
+}
+
+// AvgColumns returns result columns of avg() of fields, key columns are left out.
+func (t Table) AvgColumns() []Column {
+	var cols []Column
+	for _, c := range t.Fields() {
+		if t.keyColumn(c) {
+			continue
+		}
+		if r, ok := aggregateColumn(c, avgTypes); ok {
+			cols = append(cols, r)
+		}
+	}
+	return cols
+}
+
+// MinMaxColumns returns result columns of min() and max() of fields.
+func (t Table) MinMaxColumns() []Column {
+	var cols []Column
+	for _, c := range t.Fields() {
+		if c.Array() || !minMaxTypes[c.Type] && c.Enum == nil {
+			continue
+		}
+		c.NotNull = false
+		c.Comment = ""
+		cols = append(cols, c)
+	}
+	return cols
+}
+
+func (t Table) AggregateGoType() string {
+	return t.GoType() + "Aggregate"
+}
+
+func (t Table) AggregateVar() string {
+	return t.Var() + "AggregateType"
+}
+
+func (t Table) AggregateMinMaxVar() string {
+	return t.Var() + "AggregateMinMaxType"
+}
+
+// AggregateSQL selects aggregates of rows of the table.
+func (t Table) AggregateSQL() string {
+	b := new(bytes.Buffer)
+	b.WriteString("select ")
+	t.writeAggregates(b, "")
+	b.WriteString(" from ")
+	b.WriteString(t.FromSQL())
+	return b.String()
+}
+
+// writeAggregates writes aggregates of fields named like fields of the Go model, names are prefixed.
+func (t Table) writeAggregates(b *bytes.Buffer, prefix string) {
+	b.WriteString("count(*) as ")
+	b.WriteString(sqlgen.Ident(prefix + "count"))
+	write := func(fn string, cols []Column) {
+		for _, c := range cols {
+			b.WriteByte(',')
+			b.WriteString(fn)
+			b.WriteByte('(')
+			b.WriteString(c.Ident())
+			b.WriteString(") as ")
+			b.WriteString(sqlgen.Ident(prefix + fn + "." + c.Name))
+		}
+	}
+	write("sum", t.SumColumns())
+	write("avg", t.AvgColumns())
+	write("min", t.MinMaxColumns())
+	write("max", t.MinMaxColumns())
+}
+
+// AggregateName returns the name of the field of aggregates of the referencing table.
+func (r Reference) AggregateName() string {
+	return r.reverse + "_aggregate"
+}
+
+// AggregateLoaderVar returns the variable of the dataloader of aggregates.
+func (r Reference) AggregateLoaderVar() string {
+	return r.ForeignTable.Var() + strcase.ToCamel(r.AggregateName()) + "Loader"
+}
+
+// AggregateSQL selects aggregates of rows of the table referencing the batch of keys,
+// the key is selected as "key" and aggregates are prefixed by "node.", rows must be grouped by PartitionSQL.
+func (r Reference) AggregateSQL() string {
+	b := new(bytes.Buffer)
+	b.WriteString("select ")
+	writeKeyColumns(b, "", r.Columns, r.ForeignColumns)
+	b.WriteByte(',')
+	r.Table.writeAggregates(b, "node.")
+	b.WriteString(" from ")
+	b.WriteString(r.FromSQL())
+	return b.String()
+}
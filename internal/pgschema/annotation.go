@@ -0,0 +1,113 @@
+package pgschema
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// defaultDeprecationReason mirrors graphql.DefaultDeprecationReason.
+const defaultDeprecationReason = "No longer supported"
+
+var (
+	annotationRe    = regexp.MustCompile(`(?:^|\s)@(hidden|deprecated|name)\b(?:\s*(\((?:[^)"]|"(?:[^"\\]|\\.)*")*\)))?`)
+	annotationArgRe = regexp.MustCompile(`^\s*(\w+)\s*:\s*("(?:[^"\\]|\\.)*")\s*(?:,|$)`)
+)
+
+// Annotations are markers of COMMENT changing the generated schema:
+//
+//	@hidden
+//	@deprecated(reason: "use foo")
+//	@name(field: "customer", reverse: "orders")
+type Annotations struct {
+	Hidden bool
+	// Deprecation is the reason of the deprecated field.
+	Deprecation string
+	// Field and Reverse rename fields of a foreign key.
+	Field   string
+	Reverse string
+}
+
+// parseAnnotations cuts annotations out of the comment,
+// the rest of the comment is returned as the description.
+func parseAnnotations(comment string) (string, Annotations, error) {
+	var a Annotations
+	for _, m := range annotationRe.FindAllStringSubmatch(comment, -1) {
+		args, err := parseAnnotationArgs(m[2])
+		if err != nil {
+			return "", a, errors.WithMessagef(err, "annotation @%s", m[1])
+		}
+		switch m[1] {
+		case "hidden":
+			err = checkAnnotationArgs(args)
+			a.Hidden = true
+		case "deprecated":
+			err = checkAnnotationArgs(args, "reason")
+			a.Deprecation = args["reason"]
+			if a.Deprecation == "" {
+				a.Deprecation = defaultDeprecationReason
+			}
+		case "name":
+			err = checkAnnotationArgs(args, "field", "reverse")
+			a.Field, a.Reverse = args["field"], args["reverse"]
+		}
+		if err != nil {
+			return "", a, errors.WithMessagef(err, "annotation @%s", m[1])
+		}
+	}
+	return strings.TrimSpace(annotationRe.ReplaceAllString(comment, "")), a, nil
+}
+
+func parseAnnotationArgs(s string) (map[string]string, error) {
+	args := make(map[string]string)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
+	for strings.TrimSpace(s) != "" {
+		m := annotationArgRe.FindStringSubmatch(s)
+		if m == nil {
+			return nil, errors.Errorf("malformed arguments %q", s)
+		}
+		v, err := strconv.Unquote(m[2])
+		if err != nil {
+			return nil, errors.Wrapf(err, "argument %q", m[1])
+		}
+		args[m[1]] = v
+		s = s[len(m[0]):]
+	}
+	return args, nil
+}
+
+func checkAnnotationArgs(args map[string]string, known ...string) error {
+	for _, name := range sortedKeys(args) {
+		if !slices.Contains(known, name) {
+			return errors.Errorf("unknown argument %q", name)
+		}
+	}
+	return nil
+}
+
+// checkRelation rejects renaming of anything but foreign keys.
+func (a Annotations) checkRelation() error {
+	if a.Field != "" || a.Reverse != "" {
+		return errors.New("annotation @name: only foreign keys are renamed")
+	}
+	return nil
+}
+
+// checkPrimaryKey rejects hiding of primary key columns, they are arguments of by_pk fields and cursors.
+func (a Annotations) checkPrimaryKey() error {
+	if a.Hidden {
+		return errors.New("annotation @hidden: primary key columns are not hidden")
+	}
+	return nil
+}
+
+// checkRequired rejects hiding of columns required by insert, rows could not be inserted without them.
+func (a Annotations) checkRequired() error {
+	if a.Hidden {
+		return errors.New("annotation @hidden: required columns are not hidden")
+	}
+	return nil
+}
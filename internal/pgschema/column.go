@@ -1,12 +1,38 @@
 package pgschema
 
-import "github.com/iancoleman/strcase"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/regeda/turboql/pkg/sqlgen"
+)
 
 type Column struct {
-	Name    string `db:"attname"`
-	Type    string `db:"atttypid"`
-	Num     int    `db:"attnum"`
-	NotNull bool   `db:"attnotnull"`
+	Name       string `db:"attname"`
+	Type       string `db:"atttypid"`
+	Num        int    `db:"attnum"`
+	NotNull    bool   `db:"attnotnull"`
+	HasDefault bool   `db:"atthasdef"`
+	// Identity is "a" for GENERATED ALWAYS and "d" for GENERATED BY DEFAULT identity columns.
+	Identity string `db:"attidentity"`
+	// Generated is "s" for stored generated columns.
+	Generated string `db:"attgenerated"`
+	// ElemType is the type of elements of array columns.
+	ElemType string `db:"typelem"`
+	// Domain is the name of the domain of the column, Type is the base type of the domain then.
+	Domain string `db:"domain"`
+	// Check is the text of CHECK constraints of the domain.
+	Check string `db:"domain_check"`
+	// Comment is set by COMMENT ON COLUMN.
+	Comment     string `db:"comment"`
+	Annotations Annotations
+	// Enum is resolved by NewSchema when the column type,
+	// or the type of array elements, is a user-defined enum.
+	Enum *Enum
+	// Override is resolved by NewSchema from the config.
+	Override *TypeOverride
 }
 
 func (c Column) Title() string {
@@ -14,7 +40,61 @@ func (c Column) Title() string {
 
 }
 
+// GraphqlName returns the name of the field, it differs from the column name
+// when the column is named by characters not allowed in GraphQL.
+func (c Column) GraphqlName() string {
+	return graphqlName(c.Name)
+}
+
+// Ident returns the quoted name of the column.
+func (c Column) Ident() string {
+	return sqlgen.Ident(c.Name)
+}
+
+// Description documents the field by the comment,
+// domains are described by their constraints as well.
+func (c Column) Description() string {
+	if c.Check == "" {
+		return c.Comment
+	}
+	check := c.Domain + ": " + c.Check
+	if c.Comment == "" {
+		return check
+	}
+	return c.Comment + "\n\n" + check
+}
+
+// Writable reports whether the column value can be set by insert and update,
+// generated and GENERATED ALWAYS identity columns are computed by Postgres only.
+func (c Column) Writable() bool {
+	return c.Generated == "" && c.Identity != "a"
+}
+
+// Required reports whether the value must be supplied on insert,
+// Postgres fills columns having defaults, serial and identity columns itself.
+func (c Column) Required() bool {
+	return c.NotNull && !c.HasDefault && c.Identity == ""
+}
+
+// Array reports whether the column is an array, array types are derived from element types.
+func (c Column) Array() bool {
+	return c.ElemType != ""
+}
+
+func (c Column) elem() Column {
+	return Column{Name: c.Name, Type: c.ElemType, NotNull: true, Enum: c.Enum, Override: c.Override}
+}
+
 func (c Column) GoType() string {
+	if c.Array() {
+		return "[]" + c.elem().GoType()
+	}
+	if c.Override != nil && c.Override.GoType.Name != "" {
+		return c.Override.GoType.String()
+	}
+	if c.Enum != nil {
+		return c.Enum.GoType()
+	}
 	t, ok := goTypes[c.Type]
 	if ok {
 		return t
@@ -22,7 +102,31 @@ func (c Column) GoType() string {
 	return c.Type
 }
 
+// GoFieldType returns the type of the model field,
+// nullable columns are represented by pointers unless the type is nillable itself.
+func (c Column) GoFieldType() string {
+	t := c.GoType()
+	if c.Pointer() {
+		return "*" + t
+	}
+	return t
+}
+
+// Pointer reports whether the model field is a pointer to the column type.
+func (c Column) Pointer() bool {
+	return !c.NotNull && !nillableGoType(c.GoType())
+}
+
 func (c Column) GraphqlType() string {
+	if c.Array() {
+		return "graphql.NewList(" + c.elem().GraphqlType() + ")"
+	}
+	if c.Override != nil && c.Override.GraphqlType.Name != "" {
+		return c.Override.GraphqlType.String()
+	}
+	if c.Enum != nil {
+		return c.Enum.GraphqlVar()
+	}
 	t, ok := graphqlTypes[c.Type]
 	if ok {
 		return t
@@ -30,7 +134,57 @@ func (c Column) GraphqlType() string {
 	return c.Type
 }
 
+// GraphqlFieldType returns the type of the object field,
+// only NOT NULL columns are wrapped by graphql.NewNonNull.
+func (c Column) GraphqlFieldType() string {
+	t := c.GraphqlType()
+	if c.NotNull {
+		return "graphql.NewNonNull(" + t + ")"
+	}
+	return t
+}
+
 func (c Column) FilterType() (string, bool) {
+	if c.Array() {
+		if _, ok := c.elem().FilterType(); !ok {
+			return "", false
+		}
+		return "filter.Array(" + c.elem().GraphqlType() + ")", true
+	}
+	if c.Override != nil && c.Override.GraphqlType.Name != "" {
+		return "filter.Scalar(" + c.Override.GraphqlType.String() + ", " + strconv.FormatBool(c.Override.Comparable) + ")", true
+	}
+	if c.Enum != nil {
+		return c.Enum.FilterVar(), true
+	}
 	t, ok := filterTypes[c.Type]
 	return t, ok
 }
+
+// Supported reports whether the column type is mapped to Go and GraphQL types.
+func (c Column) Supported() bool {
+	if c.Array() {
+		return c.elem().Supported()
+	}
+	if c.Enum != nil {
+		return true
+	}
+	_, goOK := goTypes[c.Type]
+	_, graphqlOK := graphqlTypes[c.Type]
+	if o := c.Override; o != nil {
+		goOK = goOK || o.GoType.Name != ""
+		graphqlOK = graphqlOK || o.GraphqlType.Name != ""
+	}
+	return goOK && graphqlOK
+}
+
+// Orderable reports whether the column can be used by order_by.
+func (c Column) Orderable() bool {
+	return !unorderedTypes[c.Type]
+}
+
+// nillableGoType reports whether the type represents NULL itself,
+// slices are pointers because GraphQL serializes nil slices as empty lists.
+func nillableGoType(t string) bool {
+	return strings.HasPrefix(t, "pgtype.") || t == "json.RawMessage"
+}
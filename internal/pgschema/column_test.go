@@ -0,0 +1,185 @@
+package pgschema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/internal/pgschema"
+)
+
+func Test_Column_Array(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		column      pgschema.Column
+		goType      string
+		graphqlType string
+		filterType  string
+	}{
+		{
+			name:        "text",
+			column:      pgschema.Column{Type: "text[]", ElemType: "text"},
+			goType:      "*[]string",
+			graphqlType: "graphql.NewList(graphql.String)",
+			filterType:  "filter.Array(graphql.String)",
+		},
+		{
+			name:        "timestamptz",
+			column:      pgschema.Column{Type: "timestamp with time zone[]", ElemType: "timestamp with time zone", NotNull: true},
+			goType:      "[]time.Time",
+			graphqlType: "graphql.NewList(graphql.DateTime)",
+			filterType:  "filter.Array(graphql.DateTime)",
+		},
+		{
+			name: "enum",
+			column: pgschema.Column{
+				Type:     "order_state[]",
+				ElemType: "order_state",
+				Enum:     &pgschema.Enum{Name: "order_state", Type: "order_state"},
+			},
+			goType:      "*[]OrderState",
+			graphqlType: "graphql.NewList(orderStateEnum)",
+			filterType:  "filter.Array(orderStateEnum)",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.column.Array())
+			require.Equal(t, tc.goType, tc.column.GoFieldType())
+			require.Equal(t, tc.graphqlType, tc.column.GraphqlType())
+
+			filterType, ok := tc.column.FilterType()
+			require.True(t, ok)
+			require.Equal(t, tc.filterType, filterType)
+		})
+	}
+}
+
+func Test_Column_Description(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		column      pgschema.Column
+		description string
+	}{
+		{
+			name:   "none",
+			column: pgschema.Column{Type: "text"},
+		},
+		{
+			name:        "comment",
+			column:      pgschema.Column{Type: "text", Comment: "Full name."},
+			description: "Full name.",
+		},
+		{
+			name:        "domain",
+			column:      pgschema.Column{Type: "text", Domain: "email", Check: "CHECK ((VALUE ~~ '%@%'::text))"},
+			description: "email: CHECK ((VALUE ~~ '%@%'::text))",
+		},
+		{
+			name:        "domain with comment",
+			column:      pgschema.Column{Type: "text", Domain: "email", Check: "CHECK ((VALUE ~~ '%@%'::text))", Comment: "Contact."},
+			description: "Contact.\n\nemail: CHECK ((VALUE ~~ '%@%'::text))",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.description, tc.column.Description())
+		})
+	}
+}
+
+func Test_Column_NetworkTypes(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		column      pgschema.Column
+		goType      string
+		graphqlType string
+		filterType  string
+	}{
+		{
+			name:        "inet",
+			column:      pgschema.Column{Type: "inet", NotNull: true},
+			goType:      "netip.Prefix",
+			graphqlType: "scalar.IPAddress",
+			filterType:  "filter.IPAddress",
+		},
+		{
+			name:        "macaddr",
+			column:      pgschema.Column{Type: "macaddr", NotNull: true},
+			goType:      "net.HardwareAddr",
+			graphqlType: "graphql.String",
+			filterType:  "filter.MACAddress",
+		},
+		{
+			// pgx scans macaddr8 in the text format only
+			name:        "macaddr8",
+			column:      pgschema.Column{Type: "macaddr8", NotNull: true},
+			goType:      "string",
+			graphqlType: "graphql.String",
+			filterType:  "filter.MACAddress",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.column.Supported())
+			require.Equal(t, tc.goType, tc.column.GoType())
+			require.Equal(t, tc.graphqlType, tc.column.GraphqlType())
+
+			filterType, ok := tc.column.FilterType()
+			require.True(t, ok)
+			require.Equal(t, tc.filterType, filterType)
+		})
+	}
+}
+
+func Test_Column_FieldType(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		column           pgschema.Column
+		goFieldType      string
+		pointer          bool
+		graphqlFieldType string
+	}{
+		{
+			name:             "not null",
+			column:           pgschema.Column{Type: "integer", NotNull: true},
+			goFieldType:      "int",
+			graphqlFieldType: "graphql.NewNonNull(graphql.Int)",
+		},
+		{
+			name:             "nullable",
+			column:           pgschema.Column{Type: "integer"},
+			goFieldType:      "*int",
+			pointer:          true,
+			graphqlFieldType: "graphql.Int",
+		},
+		{
+			name:             "nullable bytea",
+			column:           pgschema.Column{Type: "bytea"},
+			goFieldType:      "*[]byte",
+			pointer:          true,
+			graphqlFieldType: "scalar.Bytea",
+		},
+		{
+			name:             "not null bytea",
+			column:           pgschema.Column{Type: "bytea", NotNull: true},
+			goFieldType:      "[]byte",
+			graphqlFieldType: "graphql.NewNonNull(scalar.Bytea)",
+		},
+		{
+			name:             "nullable numeric",
+			column:           pgschema.Column{Type: "numeric"},
+			goFieldType:      "pgtype.Numeric",
+			graphqlFieldType: "scalar.Numeric",
+		},
+		{
+			name:             "not null numeric",
+			column:           pgschema.Column{Type: "numeric", NotNull: true},
+			goFieldType:      "pgtype.Numeric",
+			graphqlFieldType: "graphql.NewNonNull(scalar.Numeric)",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.goFieldType, tc.column.GoFieldType())
+			require.Equal(t, tc.pointer, tc.column.Pointer())
+			require.Equal(t, tc.graphqlFieldType, tc.column.GraphqlFieldType())
+		})
+	}
+}
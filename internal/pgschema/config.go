@@ -0,0 +1,165 @@
+package pgschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Config customizes the generated schema.
+type Config struct {
+	// Types override types by the name printed by ::regtype, domains are overridden by their names.
+	Types map[string]TypeOverride `json:"types"`
+	// Columns override types of columns by "table.column" keys.
+	Columns map[string]TypeOverride `json:"columns"`
+	// Relations rename fields of foreign keys by "table.constraint" keys.
+	Relations map[string]RelationName `json:"relations"`
+}
+
+// RelationName names the field of the referencing table and the reverse field of the referenced table,
+// either of them falls back to the derived name when omitted.
+type RelationName struct {
+	Field   string `json:"field"`
+	Reverse string `json:"reverse"`
+}
+
+// LoadConfig reads the JSON config file.
+func LoadConfig(name string) (Config, error) {
+	var cfg Config
+	f, err := os.Open(name)
+	if err != nil {
+		return cfg, err
+	}
+	defer f.Close()
+
+	d := json.NewDecoder(f)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&cfg); err != nil {
+		return cfg, errors.WithMessagef(err, "decode %q", name)
+	}
+	return cfg, nil
+}
+
+// checkScalars reports GraphQL scalars overridden as comparable and not comparable,
+// the filter of a scalar is shared by all columns.
+func (cfg Config) checkScalars() error {
+	type origin struct {
+		name       string
+		comparable bool
+	}
+	scalars := make(map[TypeRef]origin)
+	check := func(kind string, overrides map[string]TypeOverride) error {
+		for _, key := range sortedKeys(overrides) {
+			o := overrides[key]
+			if o.GraphqlType.Name == "" {
+				continue
+			}
+			name := fmt.Sprintf("config %s %q", kind, key)
+			if prev, ok := scalars[o.GraphqlType]; ok && prev.comparable != o.Comparable {
+				return errors.Errorf("%s: comparable of scalar %s differs from %s", name, o.GraphqlType, prev.name)
+			}
+			scalars[o.GraphqlType] = origin{name: name, comparable: o.Comparable}
+		}
+		return nil
+	}
+	if err := check("type", cfg.Types); err != nil {
+		return err
+	}
+	return check("column", cfg.Columns)
+}
+
+// override returns the type override of the column looked up by the column, the domain and the type,
+// the override of array columns applies to elements.
+func (cfg Config) override(t Table, c Column) *TypeOverride {
+	if o, ok := cfg.Columns[t.Name+"."+c.Name]; ok {
+		return &o
+	}
+	if o, ok := cfg.Types[c.Domain]; ok && c.Domain != "" {
+		return &o
+	}
+	typ := c.Type
+	if c.Array() {
+		typ = c.ElemType
+	}
+	if o, ok := cfg.Types[typ]; ok {
+		return &o
+	}
+	return nil
+}
+
+// TypeOverride replaces the Go type of model fields and the GraphQL scalar,
+// either of them falls back to the built-in mapping when omitted.
+type TypeOverride struct {
+	GoType      TypeRef `json:"go_type"`
+	GraphqlType TypeRef `json:"graphql_type"`
+	// Comparable enables gt, lt, gte and lte filters of the GraphQL scalar.
+	Comparable bool `json:"comparable"`
+}
+
+// TypeRef refers to the identifier declared by the package,
+// the package is omitted for predeclared Go types.
+type TypeRef struct {
+	Package string `json:"package"`
+	// Alias is the import name, it defaults to the last element of the package path.
+	Alias string `json:"alias"`
+	Name  string `json:"name"`
+}
+
+func (r TypeRef) String() string {
+	if r.Package == "" {
+		return r.Name
+	}
+	return r.qualifier() + "." + r.Name
+}
+
+func (r TypeRef) qualifier() string {
+	if r.Alias != "" {
+		return r.Alias
+	}
+	return path.Base(r.Package)
+}
+
+// importSpec returns the import of the package, the alias is kept when given.
+func (r TypeRef) importSpec() string {
+	if r.Alias != "" {
+		return r.Alias + " " + strconv.Quote(r.Package)
+	}
+	return strconv.Quote(r.Package)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Imports returns import specs of packages declaring overridden types of columns.
+func (s Schema) Imports() []string {
+	seen := make(map[string]bool)
+	for _, t := range s.Tables {
+		for _, c := range t.Columns {
+			if c.Override == nil {
+				continue
+			}
+			for _, r := range []TypeRef{c.Override.GoType, c.Override.GraphqlType} {
+				if r.Package != "" {
+					seen[r.importSpec()] = true
+				}
+			}
+		}
+	}
+	imports := make([]string, 0, len(seen))
+	for spec := range seen {
+		imports = append(imports, spec)
+	}
+	sort.Strings(imports)
+	return imports
+}
@@ -0,0 +1,47 @@
+package pgschema_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/internal/pgschema"
+)
+
+func Test_LoadConfig(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "turboql.json")
+	require.NoError(t, os.WriteFile(name, []byte(`{
+		"types": {
+			"uuid": {
+				"go_type": {"package": "github.com/google/uuid", "name": "UUID"},
+				"graphql_type": {"package": "example.com/api/scalars", "name": "UUID"},
+				"comparable": true
+			}
+		},
+		"columns": {
+			"order.note": {"go_type": {"name": "string"}}
+		}
+	}`), 0o600))
+
+	cfg, err := pgschema.LoadConfig(name)
+	require.NoError(t, err)
+	require.Equal(t, pgschema.Config{
+		Types: map[string]pgschema.TypeOverride{
+			"uuid": {
+				GoType:      pgschema.TypeRef{Package: "github.com/google/uuid", Name: "UUID"},
+				GraphqlType: pgschema.TypeRef{Package: "example.com/api/scalars", Name: "UUID"},
+				Comparable:  true,
+			},
+		},
+		Columns: map[string]pgschema.TypeOverride{
+			"order.note": {GoType: pgschema.TypeRef{Name: "string"}},
+		},
+	}, cfg)
+
+	require.NoError(t, os.WriteFile(name, []byte(`{"type": {}}`), 0o600))
+
+	_, err = pgschema.LoadConfig(name)
+	require.Error(t, err)
+}
@@ -1,42 +1,109 @@
 package pgschema
 
 var goTypes = map[string]string{
-	"uuid":                        "string",
+	"smallint":                    "int16",
 	"integer":                     "int",
-	"timestamp without time zone": "time.Time",
-	"timestamp with time zone":    "time.Time",
-	"date":                        "time.Time",
-	"text":                        "string",
-	"boolean":                     "bool",
 	"bigint":                      "int64",
-	"uuid[]":                      "[]string",
+	"real":                        "float32",
+	"double precision":            "float64",
+	"numeric":                     "pgtype.Numeric",
+	"money":                       "string",
+	"oid":                         "uint32",
+	"uuid":                        "string",
+	"text":                        "string",
 	"character":                   "string",
 	"character varying":           "string",
+	"citext":                      "string",
+	"name":                        "string",
+	"boolean":                     "bool",
 	"bytea":                       "[]byte",
-	"numeric":                     "pgtype.Numeric",
+	"bit":                         "pgtype.Bits",
+	"bit varying":                 "pgtype.Bits",
+	"date":                        "time.Time",
+	"time without time zone":      "pgtype.Time",
+	"time with time zone":         "string",
+	"timestamp without time zone": "time.Time",
+	"timestamp with time zone":    "time.Time",
+	"interval":                    "pgtype.Interval",
+	"inet":                        "netip.Prefix",
+	"cidr":                        "netip.Prefix",
+	"macaddr":                     "net.HardwareAddr",
+	"macaddr8":                    "string",
+	"json":                        "json.RawMessage",
+	"jsonb":                       "json.RawMessage",
+	"xml":                         "string",
 }
 
 var graphqlTypes = map[string]string{
-	"uuid":                        "graphql.String",
+	"smallint":                    "graphql.Int",
 	"integer":                     "graphql.Int",
-	"timestamp without time zone": "graphql.DateTime",
-	"timestamp with time zone":    "graphql.DateTime",
-	"date":                        "scalar.Date",
+	"bigint":                      "scalar.BigInt",
+	"real":                        "graphql.Float",
+	"double precision":            "graphql.Float",
+	"numeric":                     "scalar.Numeric",
+	"money":                       "graphql.String",
+	"oid":                         "scalar.BigInt",
+	"uuid":                        "graphql.String",
 	"text":                        "graphql.String",
-	"boolean":                     "graphql.Boolean",
-	"bigint":                      "graphql.Int",
-	"uuid[]":                      "graphql.NewList(graphql.String)",
 	"character":                   "graphql.String",
 	"character varying":           "graphql.String",
-	"bytea":                       "graphql.String",
-	"numeric":                     "scalar.Numeric",
+	"citext":                      "graphql.String",
+	"name":                        "graphql.String",
+	"boolean":                     "graphql.Boolean",
+	"bytea":                       "scalar.Bytea",
+	"bit":                         "scalar.BitString",
+	"bit varying":                 "scalar.BitString",
+	"date":                        "scalar.Date",
+	"time without time zone":      "scalar.Time",
+	"time with time zone":         "graphql.String",
+	"timestamp without time zone": "graphql.DateTime",
+	"timestamp with time zone":    "graphql.DateTime",
+	"interval":                    "scalar.Interval",
+	"inet":                        "scalar.IPAddress",
+	"cidr":                        "scalar.IPAddress",
+	"macaddr":                     "graphql.String",
+	"macaddr8":                    "graphql.String",
+	"json":                        "scalar.JSON",
+	"jsonb":                       "scalar.JSON",
+	"xml":                         "graphql.String",
 }
 
 var filterTypes = map[string]string{
-	"text":              "filter.String",
-	"uuid":              "filter.String",
-	"integer":           "filter.Int",
-	"bigint":            "filter.Int",
-	"character":         "filter.String",
-	"character varying": "filter.String",
+	"smallint":                    "filter.Int",
+	"integer":                     "filter.Int",
+	"bigint":                      "filter.BigInt",
+	"real":                        "filter.Float",
+	"double precision":            "filter.Float",
+	"numeric":                     "filter.Numeric",
+	"money":                       "filter.Money",
+	"oid":                         "filter.BigInt",
+	"uuid":                        "filter.UUID",
+	"text":                        "filter.String",
+	"character":                   "filter.String",
+	"character varying":           "filter.String",
+	"citext":                      "filter.String",
+	"name":                        "filter.String",
+	"boolean":                     "filter.Boolean",
+	"bytea":                       "filter.Bytea",
+	"bit":                         "filter.BitString",
+	"bit varying":                 "filter.BitString",
+	"date":                        "filter.Date",
+	"time without time zone":      "filter.Time",
+	"time with time zone":         "filter.TimeTZ",
+	"timestamp without time zone": "filter.DateTime",
+	"timestamp with time zone":    "filter.DateTime",
+	"interval":                    "filter.Interval",
+	"inet":                        "filter.IPAddress",
+	"cidr":                        "filter.IPAddress",
+	"macaddr":                     "filter.MACAddress",
+	"macaddr8":                    "filter.MACAddress",
+	"json":                        "filter.JSON",
+	"jsonb":                       "filter.JSONB",
+	"xml":                         "filter.XML",
+}
+
+// unorderedTypes have no btree operator class, so columns can not be ordered.
+var unorderedTypes = map[string]bool{
+	"json": true,
+	"xml":  true,
 }
@@ -0,0 +1,123 @@
+package pgschema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
+// Enum is the user-defined enum type, values are ordered by enumsortorder.
+type Enum struct {
+	Schema string `db:"schemaname"`
+	Name   string `db:"typname"`
+	// Type is the name of the type as columns refer to it by atttypid::regtype.
+	Type   string   `db:"regtype"`
+	Values []string `db:"labels"`
+}
+
+func (e Enum) Title() string {
+	return strcase.ToCamel(e.Name)
+}
+
+// GoType returns the string type of model fields.
+func (e Enum) GoType() string {
+	return e.Title()
+}
+
+// ConstName returns the name of the Go constant of the enum value.
+func (e Enum) ConstName(value string) string {
+	return e.Title() + strcase.ToCamel(graphqlName(value))
+}
+
+// GraphqlName returns the name of the enum value in GraphQL.
+func (e Enum) GraphqlName(value string) string {
+	return graphqlName(value)
+}
+
+func (e Enum) GraphqlVar() string {
+	return strcase.ToLowerCamel(e.Name) + "Enum"
+}
+
+func (e Enum) FilterVar() string {
+	return strcase.ToLowerCamel(e.Name) + "EnumFilter"
+}
+
+// typeNames returns names of Go and GraphQL types generated for the table.
+func (t Table) typeNames() []string {
+	names := []string{t.GoType(), t.AggregateGoType()}
+	for _, suffix := range []string{"Filter", "OrderBy", "Input", "InsertInput", "Edge", "Connection"} {
+		names = append(names, t.Title()+suffix)
+	}
+	for _, suffix := range []string{"Sum", "Avg", "MinMax"} {
+		names = append(names, t.AggregateGoType()+suffix)
+	}
+	return names
+}
+
+// typeNames returns names of Go and GraphQL types generated for the enum.
+func (e Enum) typeNames() []string {
+	return []string{e.GoType(), e.Title() + "Filter", e.Title() + "ArrayFilter"}
+}
+
+// checkValues reports values of the enum having the same or a reserved name in GraphQL or Go.
+func (e Enum) checkValues() error {
+	names := make(map[string]string, len(e.Values))
+	consts := make(map[string]string, len(e.Values))
+	for _, v := range e.Values {
+		name := e.GraphqlName(v)
+		switch name {
+		case "", "true", "false", "null":
+			return errors.Errorf("value %q: invalid name %q", v, name)
+		}
+		if prev, ok := names[name]; ok {
+			return errors.Errorf("value %q: name %q collides with value %q", v, name, prev)
+		}
+		names[name] = v
+		if prev, ok := consts[e.ConstName(v)]; ok {
+			return errors.Errorf("value %q: constant %s collides with value %q", v, e.ConstName(v), prev)
+		}
+		consts[e.ConstName(v)] = v
+	}
+	return nil
+}
+
+// sharedTypes are names of GraphQL types declared by turboql packages and GraphQL itself,
+// names ending with "Filter" are reserved for filters of scalars.
+var sharedTypes = []string{
+	"String", "Int", "Float", "Boolean", "ID",
+	"BigInt", "Numeric", "Date", "Time", "Interval", "IPAddress", "BitString", "Bytea", "JSON",
+	"OrderDirection", "PageInfo",
+}
+
+// checkEnums reports enums whose types or values collide with other generated names.
+func (s Schema) checkEnums() error {
+	types := make(map[string]string)
+	for _, n := range sharedTypes {
+		types[n] = "the shared type"
+	}
+	for _, name := range sortedKeys(s.Tables) {
+		for _, n := range s.Tables[name].typeNames() {
+			types[n] = fmt.Sprintf("table %q", name)
+		}
+	}
+	for _, key := range sortedKeys(s.Enums) {
+		e := s.Enums[key]
+		if strings.HasSuffix(e.GoType(), "Filter") {
+			return errors.Errorf("enum %q: type %s is reserved for filters", e.Type, e.GoType())
+		}
+		for _, n := range e.typeNames() {
+			if prev, ok := types[n]; ok {
+				return errors.Errorf("enum %q: type %s collides with %s", e.Type, n, prev)
+			}
+		}
+		for _, n := range e.typeNames() {
+			types[n] = fmt.Sprintf("enum %q", e.Type)
+		}
+		if err := e.checkValues(); err != nil {
+			return errors.WithMessagef(err, "enum %q", e.Type)
+		}
+	}
+	return nil
+}
@@ -1,8 +1,12 @@
 package pgschema
 
 type ForeignKey struct {
-	Name         string `db:"conname"`
-	ForeignTable string `db:"confrelid"`
-	Columns      []int  `db:"conkey"`
-	Foreign      []int  `db:"confkey"`
+	Name string `db:"conname"`
+	// ForeignSchema is the schema of the referenced table, ForeignTable is its unqualified name.
+	ForeignSchema string `db:"confschema"`
+	ForeignTable  string `db:"confrelid"`
+	Columns       []int  `db:"conkey"`
+	Foreign       []int  `db:"confkey"`
+	// Comment is set by COMMENT ON CONSTRAINT.
+	Comment string `db:"comment"`
 }
@@ -0,0 +1,82 @@
+package pgschema
+
+import (
+	"bytes"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/regeda/turboql/pkg/sqlgen"
+)
+
+// Link is the many-to-many relationship through the link table,
+// the primary key of the link table consists of the Near and the Far foreign keys.
+// Near references the table having the field, Far references rows of the field.
+type Link struct {
+	Near Reference
+	Far  Reference
+}
+
+// GraphqlName returns the plural of the field name of the far foreign key.
+func (l Link) GraphqlName() string {
+	return plural(l.Far.FieldName())
+}
+
+// LoaderVar returns the variable of the dataloader of the field.
+func (l Link) LoaderVar() string {
+	return l.Near.ForeignTable.Var() + strcase.ToCamel(l.GraphqlName()) + "LinkLoader"
+}
+
+// KeyType returns the Go type of loader keys.
+func (l Link) KeyType() string {
+	return l.Near.KeyType()
+}
+
+// SelectSQL selects rows of the far table joined through the link table,
+// the key of the near table is selected as "key" and rows are prefixed by "node.".
+func (l Link) SelectSQL() string {
+	b := new(bytes.Buffer)
+	b.WriteString("select ")
+	writeKeyColumns(b, "l.", l.Near.Columns, l.Near.ForeignColumns)
+	for _, c := range l.Far.ForeignTable.Columns {
+		b.WriteString(",t.")
+		b.WriteString(c.Ident())
+		b.WriteString(" as ")
+		b.WriteString(sqlgen.Ident("node." + c.Name))
+	}
+	b.WriteString(" from ")
+	b.WriteString(l.Far.ForeignTable.Ident())
+	b.WriteString(" t join ")
+	b.WriteString(l.Far.Table.Ident())
+	b.WriteString(" l on ")
+	for i, c := range l.Far.Columns {
+		if i > 0 {
+			b.WriteString(" and ")
+		}
+		b.WriteString("l.")
+		b.WriteString(c.Ident())
+		b.WriteString("=t.")
+		b.WriteString(l.Far.ForeignColumns[i].Ident())
+	}
+	b.WriteString(" where 1=1")
+	writeKeysMatch(b, "l", l.Near.Columns)
+	return b.String()
+}
+
+// writeKeyColumns selects columns as the loader key, the composite key is scanned into fields named by keyColumns.
+func writeKeyColumns(b *bytes.Buffer, qualifier string, columns, keyColumns []Column) {
+	if len(columns) == 1 {
+		b.WriteString(qualifier)
+		b.WriteString(columns[0].Ident())
+		b.WriteString(` as "key"`)
+		return
+	}
+	for i, c := range columns {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(qualifier)
+		b.WriteString(c.Ident())
+		b.WriteString(" as ")
+		b.WriteString(sqlgen.Ident("key." + keyColumns[i].Name))
+	}
+}
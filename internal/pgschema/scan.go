@@ -8,42 +8,128 @@ import (
 	"github.com/stephenafamo/scan/pgxscan"
 )
 
+// ScanEnums reads user-defined enums of all schemas,
+// columns may use enums declared out of the scanned schema.
+func ScanEnums(ctx context.Context, pq pgxscan.Queryer) ([]Enum, error) {
+	const enumssql = `
+select
+	n.nspname as schemaname,
+	t.typname::text,
+	t.oid::regtype::text as regtype,
+	array_agg(e.enumlabel::text order by e.enumsortorder) as labels
+from
+	pg_catalog.pg_type t
+	join pg_catalog.pg_namespace n on n.oid = t.typnamespace
+	join pg_catalog.pg_enum e on e.enumtypid = t.oid
+group by
+	n.nspname, t.typname, t.oid`
+
+	enums, err := pgxscan.All(ctx, pq, scan.StructMapper[Enum](), enumssql)
+	if err != nil {
+		return nil, errors.WithMessage(err, "scan enums")
+	}
+	return enums, nil
+}
+
 func Scan(ctx context.Context, pq pgxscan.Queryer, schema string) ([]Table, error) {
 	const (
 		tablessql = `
 select
-	schemaname,
-	tablename
+	n.nspname as schemaname,
+	c.relname as tablename,
+	c.relkind::text as relkind,
+	pg_catalog.pg_relation_is_updatable(c.oid, false) as updatable,
+	coalesce(pg_catalog.obj_description(c.oid, 'pg_class'), '') as comment
 from
-	pg_catalog.pg_tables
+	pg_catalog.pg_class c
+	join pg_catalog.pg_namespace n on n.oid = c.relnamespace
 where
-	schemaname = $1`
+	n.nspname = $1
+	and c.relkind in ('r', 'p', 'v', 'm')
+	-- partitions are queried through the partitioned table
+	and not c.relispartition
+order by
+	c.relname`
+		// domains are resolved to base types through nested domains,
+		// NOT NULL and CHECK constraints of every domain of the chain apply
 		columnssql = `
+with recursive domains as (
+	select
+		oid as domain,
+		typbasetype as basetype,
+		typnotnull as notnull,
+		array[oid] as chain
+	from
+		pg_catalog.pg_type
+	where
+		typtype = 'd'
+	union all
+	select
+		d.domain,
+		t.typbasetype,
+		d.notnull or t.typnotnull,
+		d.chain || t.oid
+	from
+		domains d
+		join pg_catalog.pg_type t on t.oid = d.basetype and t.typtype = 'd'
+),
+resolved as (
+	select distinct on (domain)
+		domain,
+		basetype,
+		notnull,
+		chain
+	from
+		domains
+	order by
+		domain, cardinality(chain) desc
+)
 select
-	attname,
-    atttypid::regtype,
-	attnum,
-	attnotnull
+	a.attname,
+	coalesce(d.basetype, a.atttypid)::regtype::text as atttypid,
+	a.attnum,
+	a.attnotnull or coalesce(d.notnull, false) as attnotnull,
+	a.atthasdef,
+	a.attidentity::text,
+	a.attgenerated::text,
+	coalesce((
+		select typelem::regtype::text
+		from pg_catalog.pg_type
+		where oid = coalesce(d.basetype, a.atttypid) and typcategory = 'A'
+	), '') as typelem,
+	coalesce(d.domain::regtype::text, '') as domain,
+	coalesce((
+		select string_agg(pg_catalog.pg_get_constraintdef(c.oid), ' and ' order by c.conname)
+		from pg_catalog.pg_constraint c
+		where c.contypid = any(d.chain) and c.contype = 'c'
+	), '') as domain_check,
+	coalesce(pg_catalog.col_description(a.attrelid, a.attnum), '') as comment
 from
-	pg_catalog.pg_attribute
+	pg_catalog.pg_attribute a
+	left join resolved d on d.domain = a.atttypid
 where
-	attrelid = $1::regclass
-	and attnum > 0
-	and not attisdropped
+	a.attrelid = $1::regclass
+	and a.attnum > 0
+	and not a.attisdropped
 order by
-	attnum`
+	a.attnum`
 		fksql = `
 select
 	conname,
-	confrelid::regclass,
+	fn.nspname as confschema,
+	f.relname as confrelid,
 	conkey,
-	confkey
+	confkey,
+	coalesce(pg_catalog.obj_description(pg_constraint.oid, 'pg_constraint'), '') as comment
 from
 	pg_catalog.pg_constraint
+	join pg_catalog.pg_class f on f.oid = confrelid
+	join pg_catalog.pg_namespace fn on fn.oid = f.relnamespace
 where
 	conrelid = $1::regclass
 	and contype = 'f'
-	and confrelid > 0
+order by
+	conname
 `
 		pksql = `
 select
@@ -70,17 +156,20 @@ where
 	}
 
 	for i, t := range tables {
-		tables[i].Columns, err = pgxscan.All(ctx, pq, columnMapper, columnssql, t.Name)
+		// the qualified and quoted name is resolved by regclass regardless of the search path
+		ident := t.Ident()
+
+		tables[i].Columns, err = pgxscan.All(ctx, pq, columnMapper, columnssql, ident)
 		if err != nil {
 			return nil, errors.WithMessagef(err, "scan columns for %q", t.Name)
 		}
 
-		tables[i].ForeignKeys, err = pgxscan.All(ctx, pq, fkMapper, fksql, t.Name)
+		tables[i].ForeignKeys, err = pgxscan.All(ctx, pq, fkMapper, fksql, ident)
 		if err != nil {
 			return nil, errors.WithMessagef(err, "scan foreign keys for %q", t.Name)
 		}
 
-		tables[i].PrimaryKeys, err = pgxscan.All(ctx, pq, pkMapper, pksql, t.Name)
+		tables[i].PrimaryKeys, err = pgxscan.All(ctx, pq, pkMapper, pksql, ident)
 		if err != nil {
 			return nil, errors.WithMessagef(err, "scan primary keys for %q", t.Name)
 		}
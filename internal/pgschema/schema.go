@@ -1,42 +1,359 @@
 package pgschema
 
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pkg/errors"
+)
+
 type Schema struct {
 	Tables     map[string]Table
 	References map[string][]Reference
+	// ForeignReferences are keyed by the referencing table.
+	ForeignReferences map[string][]Reference
+	// Links are many-to-many relationships keyed by the table having the field.
+	Links map[string][]Link
+	// Enums are used by columns of tables, keyed by the type name.
+	Enums map[string]Enum
 }
 
-func NewSchema(tables []Table) Schema {
+// NewSchema resolves types of columns and references between tables,
+// the error names the column of an unsupported type.
+func NewSchema(tables []Table, enums []Enum, cfg Config) (Schema, error) {
 	s := Schema{
-		Tables:     make(map[string]Table, len(tables)),
-		References: make(map[string][]Reference),
+		Tables:            make(map[string]Table, len(tables)),
+		References:        make(map[string][]Reference),
+		ForeignReferences: make(map[string][]Reference),
+		Links:             make(map[string][]Link),
+		Enums:             make(map[string]Enum),
+	}
+
+	if err := cfg.checkScalars(); err != nil {
+		return s, err
+	}
+
+	enumTypes := make(map[string]Enum, len(enums))
+	for _, e := range enums {
+		enumTypes[e.Type] = e
 	}
 
+	overridden := make(map[string]bool, len(cfg.Columns))
 	for _, t := range tables {
+		var err error
+		if t.Comment, t.Annotations, err = parseAnnotations(t.Comment); err != nil {
+			return s, errors.WithMessagef(err, "table %q", t.Name)
+		}
+		if err = t.Annotations.checkRelation(); err != nil {
+			return s, errors.WithMessagef(err, "table %q", t.Name)
+		}
+		if t.Annotations.Hidden {
+			continue
+		}
+		columns := make([]Column, len(t.Columns))
+		for i, c := range t.Columns {
+			if c.Comment, c.Annotations, err = parseAnnotations(c.Comment); err != nil {
+				return s, errors.WithMessagef(err, "table %q column %q", t.Name, c.Name)
+			}
+			if err = c.Annotations.checkRelation(); err != nil {
+				return s, errors.WithMessagef(err, "table %q column %q", t.Name, c.Name)
+			}
+			if len(t.PrimaryKeys) > 0 && slices.Contains(t.PrimaryKeys[0].Columns, c.Num) {
+				if err = c.Annotations.checkPrimaryKey(); err != nil {
+					return s, errors.WithMessagef(err, "table %q column %q", t.Name, c.Name)
+				}
+			}
+			if c.Required() && c.Generated == "" {
+				if err = c.Annotations.checkRequired(); err != nil {
+					return s, errors.WithMessagef(err, "table %q column %q", t.Name, c.Name)
+				}
+			}
+			if _, ok := cfg.Columns[t.Name+"."+c.Name]; ok {
+				overridden[t.Name+"."+c.Name] = true
+			}
+			c.Override = cfg.override(t, c)
+			if e, ok := enumTypes[c.Type]; ok {
+				c.Enum = &e
+				s.Enums[e.Type] = e
+			}
+			if e, ok := enumTypes[c.ElemType]; ok {
+				c.Enum = &e
+				s.Enums[e.Type] = e
+			}
+			if !c.Supported() {
+				if c.Domain != "" {
+					return s, errors.Errorf("table %q column %q: unsupported type %q of domain %q", t.Name, c.Name, c.Type, c.Domain)
+				}
+				return s, errors.Errorf("table %q column %q: unsupported type %q", t.Name, c.Name, c.Type)
+			}
+			columns[i] = c
+		}
+		t.Columns = columns
 		s.Tables[t.Name] = t
 	}
 
+	if err := s.checkEnums(); err != nil {
+		return s, err
+	}
+
+	for _, key := range sortedKeys(cfg.Columns) {
+		if !overridden[key] {
+			return s, errors.Errorf("config column %q: no such column", key)
+		}
+	}
+
+	renamed := make(map[string]bool, len(cfg.Relations))
 	for _, t := range tables {
+		t, ok := s.Tables[t.Name]
+		if !ok {
+			continue
+		}
+		var refs []Reference
 		for _, fk := range t.ForeignKeys {
-			foreignCol, _ := s.Tables[fk.ForeignTable].ColumnAt(fk.Foreign[0])
-			col, _ := t.ColumnAt(fk.Columns[0])
+			// tables of other schemas and hidden tables are not generated
+			if fk.ForeignSchema != t.Schema {
+				continue
+			}
+			if _, ok := s.Tables[fk.ForeignTable]; !ok {
+				continue
+			}
+			_, a, err := parseAnnotations(fk.Comment)
+			if err != nil {
+				return s, errors.WithMessagef(err, "table %q foreign key %q", t.Name, fk.Name)
+			}
+			if name, ok := cfg.Relations[t.Name+"."+fk.Name]; ok {
+				renamed[t.Name+"."+fk.Name] = true
+				a.Field = cmp.Or(name.Field, a.Field)
+				a.Reverse = cmp.Or(name.Reverse, a.Reverse)
+			}
+			if a.Hidden {
+				continue
+			}
+			ref := Reference{
+				Name:         fk.Name,
+				Table:        t,
+				ForeignTable: s.Tables[fk.ForeignTable],
+				Annotations:  a,
+			}
+			// conkey and confkey are paired by position
+			for i := range fk.Columns {
+				col, _ := t.ColumnAt(fk.Columns[i])
+				foreignCol, _ := ref.ForeignTable.ColumnAt(fk.Foreign[i])
+				ref.Columns = append(ref.Columns, col)
+				ref.ForeignColumns = append(ref.ForeignColumns, foreignCol)
+			}
+			refs = append(refs, ref)
+		}
+		nameReferences(refs)
+		s.ForeignReferences[t.Name] = refs
+		for _, ref := range refs {
+			s.References[ref.ForeignTable.Name] = append(s.References[ref.ForeignTable.Name], ref)
+		}
+		if near, far, ok := linkReferences(t, refs); ok {
+			s.Links[near.ForeignTable.Name] = append(s.Links[near.ForeignTable.Name], Link{Near: near, Far: far})
+			s.Links[far.ForeignTable.Name] = append(s.Links[far.ForeignTable.Name], Link{Near: far, Far: near})
+		}
+	}
+
+	for _, key := range sortedKeys(cfg.Relations) {
+		if !renamed[key] {
+			return s, errors.Errorf("config relation %q: no such foreign key", key)
+		}
+	}
+
+	for _, t := range tables {
+		if t, ok := s.Tables[t.Name]; ok {
+			if err := s.checkFieldNames(t); err != nil {
+				return s, errors.WithMessagef(err, "table %q", t.Name)
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// nameReferences derives field names of foreign keys of the table unless renamed,
+// the field is named by the column without the "_id" suffix and the reverse field by the plural of the table.
+// Other columns and composite keys name the field by the referenced table,
+// such fields and reverse fields of foreign keys referencing the same table are qualified by the column.
+func nameReferences(refs []Reference) {
+	foreign := make(map[string]int, len(refs))
+	for _, ref := range refs {
+		foreign[ref.ForeignTable.Name]++
+	}
+	for i, ref := range refs {
+		shared := foreign[ref.ForeignTable.Name] > 1
+
+		var column string
+		field := ref.ForeignTable.Name
+		if !ref.Composite() {
+			name, ok := strings.CutSuffix(ref.Columns[0].Name, "_id")
+			if ok && name != "" {
+				field = name
+			} else {
+				column = ref.Columns[0].Name
+				if shared {
+					field += "_by_" + column
+				}
+			}
+		}
+		refs[i].field = graphqlName(cmp.Or(ref.Annotations.Field, field))
+
+		reverse := plural(ref.Table.Name)
+		if shared {
+			reverse += "_by_" + cmp.Or(ref.Annotations.Field, column, field)
+		}
+		refs[i].reverse = graphqlName(cmp.Or(ref.Annotations.Reverse, reverse))
+	}
+}
+
+// checkFieldNames reports the first field of the table colliding with another one,
+// fields are visited in the order of columns, foreign references, references and links.
+func (s Schema) checkFieldNames(t Table) error {
+	fields := make(map[string]string)
+	add := func(name, origin string) error {
+		if prev, ok := fields[name]; ok {
+			return errors.Errorf("field %q of %s collides with %s", name, origin, prev)
+		}
+		fields[name] = origin
+		return nil
+	}
+	for _, c := range t.Fields() {
+		if err := add(c.GraphqlName(), fmt.Sprintf("column %q", c.Name)); err != nil {
+			return err
+		}
+	}
+	for _, ref := range t.ForeignReferences(s) {
+		if err := add(ref.FieldName(), fmt.Sprintf("foreign key %q", ref.Name)); err != nil {
+			return err
+		}
+	}
+	for _, ref := range t.References(s) {
+		origin := fmt.Sprintf("foreign key %q of table %q", ref.Name, ref.Table.Name)
+		if err := add(ref.ReverseName(), origin); err != nil {
+			return err
+		}
+		if err := add(ref.AggregateName(), origin); err != nil {
+			return err
+		}
+	}
+	for _, l := range t.Links(s) {
+		if err := add(l.GraphqlName(), fmt.Sprintf("link table %q", l.Near.Table.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			s.References[fk.ForeignTable] = append(
-				s.References[fk.ForeignTable],
-				Reference{
-					Name:          fk.Name,
-					Table:         t,
-					Column:        col,
-					ForeignColumn: foreignCol,
-				})
+// linkReferences detects the link table of the many-to-many relationship,
+// the primary key must consist of columns of exactly two foreign keys.
+func linkReferences(t Table, refs []Reference) (Reference, Reference, bool) {
+	pk := make(map[int]bool)
+	for _, c := range t.PrimaryKeyColumns() {
+		pk[c.Num] = true
+	}
+	var keys []Reference
+	for _, ref := range refs {
+		if slices.ContainsFunc(ref.Columns, func(c Column) bool { return !pk[c.Num] }) {
+			continue
 		}
+		keys = append(keys, ref)
+	}
+	if len(keys) != 2 || len(keys[0].Columns)+len(keys[1].Columns) != len(pk) {
+		return Reference{}, Reference{}, false
+	}
+	// the keys overlap unless every column of the primary key is covered
+	covered := make(map[int]bool)
+	for _, ref := range keys {
+		for _, c := range ref.Columns {
+			covered[c.Num] = true
+		}
+	}
+	if len(covered) != len(pk) {
+		return Reference{}, Reference{}, false
 	}
+	return keys[0], keys[1], true
+}
 
-	return s
+// Mutable reports whether any table supports mutations.
+func (s Schema) Mutable() bool {
+	for _, t := range s.Tables {
+		if t.Insertable() || t.Updatable() || t.Deletable() {
+			return true
+		}
+	}
+	return false
 }
 
+// Reference is the foreign key of Table referencing ForeignTable,
+// Columns[i] references ForeignColumns[i].
 type Reference struct {
-	Name          string
-	Table         Table
-	Column        Column
-	ForeignColumn Column
+	Name           string
+	Table          Table
+	Columns        []Column
+	ForeignTable   Table
+	ForeignColumns []Column
+	Annotations    Annotations
+
+	field, reverse string
+}
+
+// FieldName returns the name of the field of the referencing table.
+func (r Reference) FieldName() string {
+	return r.field
+}
+
+// ReverseName returns the name of the field of the referenced table.
+func (r Reference) ReverseName() string {
+	return r.reverse
+}
+
+// LoaderVar returns the variable of the dataloader of the field.
+func (r Reference) LoaderVar() string {
+	return r.Table.Var() + strcase.ToCamel(r.field) + "Loader"
+}
+
+// ReverseLoaderVar returns the variable of the dataloader of the reverse field.
+func (r Reference) ReverseLoaderVar() string {
+	return r.ForeignTable.Var() + strcase.ToCamel(r.reverse) + "Loader"
+}
+
+// Composite reports whether the foreign key consists of multiple columns.
+func (r Reference) Composite() bool {
+	return len(r.Columns) > 1
+}
+
+// KeyType returns the Go type of loader keys,
+// composite keys are batched by the generated struct.
+func (r Reference) KeyType() string {
+	if r.Composite() {
+		return r.Table.Var() + strcase.ToCamel(r.Name) + "Key"
+	}
+	return r.ForeignColumns[0].GoType()
+}
+
+// FromSQL returns the from clause of rows of the table referencing the batch of keys.
+func (r Reference) FromSQL() string {
+	return r.Table.FromSQL(r.Columns...)
+}
+
+// PartitionSQL returns columns partitioning rows of the table by keys.
+func (r Reference) PartitionSQL() string {
+	b := new(bytes.Buffer)
+	for i, c := range r.Columns {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(c.Ident())
+	}
+	return b.String()
+}
+
+// ForeignSelectSQL selects rows of the referenced table by the batch of keys.
+func (r Reference) ForeignSelectSQL() string {
+	return r.ForeignTable.SelectSQL(r.ForeignColumns...)
 }
@@ -0,0 +1,665 @@
+package pgschema_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/regeda/turboql/internal/pgschema"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewSchema_CompositeForeignKey(t *testing.T) {
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name: "account",
+			Columns: []pgschema.Column{
+				{Name: "tenant_id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "id", Type: "integer", Num: 2, NotNull: true},
+			},
+		},
+		{
+			Name: "invoice",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "account_id", Type: "integer", Num: 2},
+				{Name: "tenant_id", Type: "integer", Num: 3, NotNull: true},
+			},
+			ForeignKeys: []pgschema.ForeignKey{
+				{
+					Name:         "invoice_account_fk",
+					ForeignTable: "account",
+					Columns:      []int{3, 2},
+					Foreign:      []int{1, 2},
+				},
+			},
+		},
+	}, nil, pgschema.Config{})
+	require.NoError(t, err)
+
+	refs := schema.References["account"]
+	require.Len(t, refs, 1)
+
+	ref := refs[0]
+	require.True(t, ref.Composite())
+	require.Equal(t, "invoiceInvoiceAccountFkKey", ref.KeyType())
+	require.Equal(t, []string{"tenant_id", "account_id"}, columnNames(ref.Columns))
+	require.Equal(t, []string{"tenant_id", "id"}, columnNames(ref.ForeignColumns))
+}
+
+func Test_NewSchema_ForeignKeyOfOtherSchema(t *testing.T) {
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Schema: "public",
+			Name:   "account",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+			},
+		},
+		{
+			Schema: "public",
+			Name:   "invoice",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "account_id", Type: "integer", Num: 2},
+				{Name: "archived_account_id", Type: "integer", Num: 3},
+			},
+			ForeignKeys: []pgschema.ForeignKey{
+				{Name: "fk_account", ForeignSchema: "public", ForeignTable: "account", Columns: []int{2}, Foreign: []int{1}},
+				{Name: "fk_archived_account", ForeignSchema: "archive", ForeignTable: "account", Columns: []int{3}, Foreign: []int{1}},
+			},
+		},
+	}, nil, pgschema.Config{})
+	require.NoError(t, err)
+
+	refs := schema.ForeignReferences["invoice"]
+	require.Len(t, refs, 1)
+	require.Equal(t, "fk_account", refs[0].Name)
+}
+
+func columnNames(columns []pgschema.Column) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+func Test_NewSchema_Enum(t *testing.T) {
+	state := pgschema.Enum{
+		Schema: "public",
+		Name:   "order_state",
+		Type:   "order_state",
+		Values: []string{"new", "in progress"},
+	}
+
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name: "order",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "state", Type: "order_state", Num: 2},
+			},
+		},
+	}, []pgschema.Enum{
+		state,
+		{Name: "unused", Type: "unused", Values: []string{"a"}},
+	}, pgschema.Config{})
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]pgschema.Enum{"order_state": state}, schema.Enums)
+
+	col := schema.Tables["order"].Columns[1]
+	require.Equal(t, "*OrderState", col.GoFieldType())
+	require.Equal(t, "orderStateEnum", col.GraphqlType())
+
+	filterType, ok := col.FilterType()
+	require.True(t, ok)
+	require.Equal(t, "orderStateEnumFilter", filterType)
+
+	require.Equal(t, "OrderStateInProgress", state.ConstName("in progress"))
+	require.Equal(t, "in_progress", state.GraphqlName("in progress"))
+}
+
+func Test_NewSchema_Enum_Collision(t *testing.T) {
+	cases := []struct {
+		name     string
+		enum     string
+		table    string
+		values   []string
+		expected string
+	}{
+		{
+			name:     "type of the table",
+			table:    "order_state",
+			values:   []string{"new"},
+			expected: `enum "order_state": type OrderState collides with table "order_state"`,
+		},
+		{
+			name:     "filter of the table",
+			table:    "order_state_array",
+			values:   []string{"new"},
+			expected: `enum "order_state": type OrderStateArrayFilter collides with table "order_state_array"`,
+		},
+		{
+			name:     "shared type",
+			enum:     "page_info",
+			table:    "order",
+			values:   []string{"new"},
+			expected: `enum "page_info": type PageInfo collides with the shared type`,
+		},
+		{
+			name:     "scalar type",
+			enum:     "date",
+			table:    "order",
+			values:   []string{"new"},
+			expected: `enum "date": type Date collides with the shared type`,
+		},
+		{
+			name:     "filter type",
+			enum:     "state_filter",
+			table:    "order",
+			values:   []string{"new"},
+			expected: `enum "state_filter": type StateFilter is reserved for filters`,
+		},
+		{
+			name:     "values of the same name",
+			table:    "order",
+			values:   []string{"in progress", "in_progress"},
+			expected: `enum "order_state": value "in_progress": name "in_progress" collides with value "in progress"`,
+		},
+		{
+			name:     "values of the same constant",
+			table:    "order",
+			values:   []string{"in_progress", "InProgress"},
+			expected: `enum "order_state": value "InProgress": constant OrderStateInProgress collides with value "in_progress"`,
+		},
+		{
+			name:     "reserved name",
+			table:    "order",
+			values:   []string{"true", "false"},
+			expected: `enum "order_state": value "true": invalid name "true"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			enum := cmp.Or(c.enum, "order_state")
+			_, err := pgschema.NewSchema([]pgschema.Table{
+				{
+					Name: c.table,
+					Columns: []pgschema.Column{
+						{Name: "state", Type: enum, Num: 1},
+					},
+				},
+			}, []pgschema.Enum{
+				{Schema: "public", Name: enum, Type: enum, Values: c.values},
+			}, pgschema.Config{})
+
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func Test_NewSchema_UnsupportedType(t *testing.T) {
+	_, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name: "place",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "location", Type: "point", Num: 2},
+			},
+		},
+	}, nil, pgschema.Config{})
+
+	require.EqualError(t, err, `table "place" column "location": unsupported type "point"`)
+}
+
+func Test_NewSchema_TypeOverride(t *testing.T) {
+	uuid := pgschema.TypeOverride{
+		GoType:      pgschema.TypeRef{Package: "github.com/google/uuid", Name: "UUID"},
+		GraphqlType: pgschema.TypeRef{Package: "example.com/api/scalars", Name: "UUID"},
+		Comparable:  true,
+	}
+
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name: "order",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "uuid", Num: 1, NotNull: true},
+				{Name: "related", Type: "uuid[]", ElemType: "uuid", Num: 2},
+				{Name: "amount", Type: "numeric", Num: 3},
+			},
+		},
+	}, nil, pgschema.Config{
+		Types: map[string]pgschema.TypeOverride{
+			"uuid": uuid,
+		},
+		Columns: map[string]pgschema.TypeOverride{
+			"order.amount": {
+				GoType: pgschema.TypeRef{Package: "github.com/shopspring/decimal", Alias: "dec", Name: "Decimal"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	id, related, amount := schema.Tables["order"].Columns[0], schema.Tables["order"].Columns[1], schema.Tables["order"].Columns[2]
+
+	require.Equal(t, "uuid.UUID", id.GoFieldType())
+	require.Equal(t, "scalars.UUID", id.GraphqlType())
+	filterType, _ := id.FilterType()
+	require.Equal(t, "filter.Scalar(scalars.UUID, true)", filterType)
+
+	require.Equal(t, "*[]uuid.UUID", related.GoFieldType())
+	require.Equal(t, "graphql.NewList(scalars.UUID)", related.GraphqlType())
+	filterType, _ = related.FilterType()
+	require.Equal(t, "filter.Array(scalars.UUID)", filterType)
+
+	require.Equal(t, "*dec.Decimal", amount.GoFieldType())
+	require.Equal(t, "scalar.Numeric", amount.GraphqlType())
+
+	require.Equal(t, []string{
+		`"example.com/api/scalars"`,
+		`"github.com/google/uuid"`,
+		`dec "github.com/shopspring/decimal"`,
+	}, schema.Imports())
+}
+
+func Test_NewSchema_TypeOverride_UnknownColumn(t *testing.T) {
+	_, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name:    "order",
+			Columns: []pgschema.Column{{Name: "id", Type: "integer", Num: 1}},
+		},
+	}, nil, pgschema.Config{
+		Columns: map[string]pgschema.TypeOverride{
+			"order.uid": {GoType: pgschema.TypeRef{Name: "string"}},
+		},
+	})
+
+	require.EqualError(t, err, `config column "order.uid": no such column`)
+}
+
+func Test_NewSchema_TypeOverride_Comparable(t *testing.T) {
+	uuid := pgschema.TypeRef{Package: "example.com/api/scalars", Name: "UUID"}
+
+	_, err := pgschema.NewSchema(nil, nil, pgschema.Config{
+		Types: map[string]pgschema.TypeOverride{
+			"uuid": {GraphqlType: uuid, Comparable: true},
+		},
+		Columns: map[string]pgschema.TypeOverride{
+			"order.uid": {GraphqlType: uuid},
+		},
+	})
+
+	require.EqualError(t, err, `config column "order.uid": comparable of scalar scalars.UUID differs from config type "uuid"`)
+}
+
+func Test_NewSchema_Domain(t *testing.T) {
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name: "customer",
+			Columns: []pgschema.Column{
+				{Name: "email", Type: "citext", Domain: "email_address", Check: "CHECK ((VALUE ~~ '%@%'::citext))", Num: 1},
+				{Name: "age", Type: "integer", Domain: "positive_int", Num: 2, NotNull: true},
+			},
+		},
+	}, nil, pgschema.Config{
+		Types: map[string]pgschema.TypeOverride{
+			"positive_int": {GoType: pgschema.TypeRef{Name: "uint"}},
+		},
+	})
+	require.NoError(t, err)
+
+	email, age := schema.Tables["customer"].Columns[0], schema.Tables["customer"].Columns[1]
+
+	require.Equal(t, "*string", email.GoFieldType())
+	require.Equal(t, "graphql.String", email.GraphqlType())
+	require.Equal(t, "email_address: CHECK ((VALUE ~~ '%@%'::citext))", email.Description())
+
+	require.Equal(t, "uint", age.GoFieldType())
+	require.Equal(t, "graphql.Int", age.GraphqlType())
+	require.Empty(t, age.Description())
+
+	_, err = pgschema.NewSchema([]pgschema.Table{
+		{
+			Name:    "place",
+			Columns: []pgschema.Column{{Name: "location", Type: "point", Domain: "geo", Num: 1}},
+		},
+	}, nil, pgschema.Config{})
+	require.EqualError(t, err, `table "place" column "location": unsupported type "point" of domain "geo"`)
+}
+
+func Test_NewSchema_Annotations(t *testing.T) {
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name:    "customer",
+			Comment: "Buyers. @deprecated",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "name", Type: "text", Num: 2, Comment: `Full name. @deprecated(reason: "use \"first_name\"")`},
+				{Name: "password", Type: "text", Num: 3, Comment: "@hidden"},
+				{Name: "nick", Type: "text", Num: 4, Comment: `Nickname. @deprecated (reason: "use name")`},
+				{Name: "token", Type: "text", Num: 5, NotNull: true, HasDefault: true, Comment: "@hidden"},
+			},
+		},
+		{
+			Name: "cust_order",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "customer_id", Type: "integer", Num: 2},
+				{Name: "referrer_id", Type: "integer", Num: 3},
+			},
+			ForeignKeys: []pgschema.ForeignKey{
+				{Name: "fk_customer", ForeignTable: "customer", Columns: []int{2}, Foreign: []int{1}, Comment: `@name(field: "customer", reverse: "orders")`},
+				{Name: "fk_referrer", ForeignTable: "customer", Columns: []int{3}, Foreign: []int{1}, Comment: "@hidden"},
+				{Name: "fk_audit", ForeignTable: "audit", Columns: []int{1}, Foreign: []int{1}},
+			},
+		},
+		{
+			Name:    "audit",
+			Comment: "@hidden",
+			Columns: []pgschema.Column{
+				{Name: "id", Type: "integer", Num: 1, NotNull: true},
+			},
+		},
+	}, nil, pgschema.Config{})
+	require.NoError(t, err)
+
+	require.NotContains(t, schema.Tables, "audit")
+
+	customer := schema.Tables["customer"]
+	require.Equal(t, "Buyers.", customer.Comment)
+	require.Equal(t, "No longer supported", customer.Annotations.Deprecation)
+	require.Equal(t, []string{"id", "name", "password", "nick", "token"}, columnNames(customer.Columns))
+	require.Equal(t, []string{"id", "name", "nick"}, columnNames(customer.Fields()))
+	require.Equal(t, "Full name.", customer.Columns[1].Comment)
+	require.Equal(t, `use "first_name"`, customer.Columns[1].Annotations.Deprecation)
+	require.Equal(t, "Nickname.", customer.Columns[3].Comment)
+	require.Equal(t, "use name", customer.Columns[3].Annotations.Deprecation)
+
+	refs := schema.References["customer"]
+	require.Len(t, refs, 1)
+	require.Equal(t, "customer", refs[0].FieldName())
+	require.Equal(t, "orders", refs[0].ReverseName())
+}
+
+func Test_NewSchema_Annotations_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		table   pgschema.Table
+		message string
+	}{
+		{
+			name: "unknown argument",
+			table: pgschema.Table{
+				Name:    "customer",
+				Comment: `@deprecated(why: "old")`,
+			},
+			message: `table "customer": annotation @deprecated: unknown argument "why"`,
+		},
+		{
+			name: "malformed arguments",
+			table: pgschema.Table{
+				Name: "customer",
+				Columns: []pgschema.Column{
+					{Name: "id", Type: "integer", Num: 1, Comment: `@deprecated(reason: old)`},
+				},
+			},
+			message: `table "customer" column "id": annotation @deprecated: malformed arguments "reason: old"`,
+		},
+		{
+			name: "renamed column",
+			table: pgschema.Table{
+				Name: "customer",
+				Columns: []pgschema.Column{
+					{Name: "id", Type: "integer", Num: 1, Comment: `@name(field: "key")`},
+				},
+			},
+			message: `table "customer" column "id": annotation @name: only foreign keys are renamed`,
+		},
+		{
+			name: "hidden primary key",
+			table: pgschema.Table{
+				Name: "customer",
+				Columns: []pgschema.Column{
+					{Name: "id", Type: "integer", Num: 1, Comment: "@hidden"},
+				},
+				PrimaryKeys: []pgschema.PrimaryKey{{Name: "pk_customer", Columns: []int{1}}},
+			},
+			message: `table "customer" column "id": annotation @hidden: primary key columns are not hidden`,
+		},
+		{
+			name: "hidden required column",
+			table: pgschema.Table{
+				Name: "customer",
+				Columns: []pgschema.Column{
+					{Name: "id", Type: "integer", Num: 1, NotNull: true, Identity: "a"},
+					{Name: "email", Type: "text", Num: 2, NotNull: true, Comment: "@hidden"},
+				},
+			},
+			message: `table "customer" column "email": annotation @hidden: required columns are not hidden`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := pgschema.NewSchema([]pgschema.Table{tc.table}, nil, pgschema.Config{})
+			require.EqualError(t, err, tc.message)
+		})
+	}
+}
+
+func Test_NewSchema_Link(t *testing.T) {
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name: "book",
+			Columns: []pgschema.Column{
+				{Name: "book_id", Type: "integer", Num: 1, NotNull: true},
+			},
+			PrimaryKeys: []pgschema.PrimaryKey{{Name: "pk_book", Columns: []int{1}}},
+		},
+		{
+			Name: "author",
+			Columns: []pgschema.Column{
+				{Name: "author_id", Type: "integer", Num: 1, NotNull: true},
+			},
+			PrimaryKeys: []pgschema.PrimaryKey{{Name: "pk_author", Columns: []int{1}}},
+		},
+		{
+			Name: "book_author",
+			Columns: []pgschema.Column{
+				{Name: "book_id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "author_id", Type: "integer", Num: 2, NotNull: true},
+			},
+			PrimaryKeys: []pgschema.PrimaryKey{{Name: "pk_book_author", Columns: []int{1, 2}}},
+			ForeignKeys: []pgschema.ForeignKey{
+				{Name: "fk_book", ForeignTable: "book", Columns: []int{1}, Foreign: []int{1}},
+				{Name: "fk_author", ForeignTable: "author", Columns: []int{2}, Foreign: []int{1}},
+			},
+		},
+		{
+			Name: "review",
+			Columns: []pgschema.Column{
+				{Name: "review_id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "book_id", Type: "integer", Num: 2, NotNull: true},
+				{Name: "author_id", Type: "integer", Num: 3, NotNull: true},
+			},
+			PrimaryKeys: []pgschema.PrimaryKey{{Name: "pk_review", Columns: []int{1}}},
+			ForeignKeys: []pgschema.ForeignKey{
+				{Name: "fk_review_book", ForeignTable: "book", Columns: []int{2}, Foreign: []int{1}},
+				{Name: "fk_review_author", ForeignTable: "author", Columns: []int{3}, Foreign: []int{1}},
+			},
+		},
+	}, nil, pgschema.Config{})
+	require.NoError(t, err)
+
+	require.Len(t, schema.Links["book"], 1)
+	require.Len(t, schema.Links["author"], 1)
+
+	authors := schema.Links["book"][0]
+	require.Equal(t, "authors", authors.GraphqlName())
+	require.Equal(t, "bookAuthorsLinkLoader", authors.LoaderVar())
+	require.Equal(t, "int", authors.KeyType())
+	require.Equal(t,
+		`select l."book_id" as "key",t."author_id" as "node.author_id" from "author" t join "book_author" l on l."author_id"=t."author_id" where 1=1 and l."book_id" = any($1)`,
+		authors.SelectSQL(),
+	)
+
+	require.Equal(t, "books", schema.Links["author"][0].GraphqlName())
+}
+
+func Test_NewSchema_RelationNames(t *testing.T) {
+	tables := func() []pgschema.Table {
+		return []pgschema.Table{
+			{
+				Name: "address",
+				Columns: []pgschema.Column{
+					{Name: "address_id", Type: "integer", Num: 1, NotNull: true},
+				},
+			},
+			{
+				Name: "customer",
+				Columns: []pgschema.Column{
+					{Name: "customer_id", Type: "integer", Num: 1, NotNull: true},
+				},
+			},
+			{
+				Name: "cust_order",
+				Columns: []pgschema.Column{
+					{Name: "order_id", Type: "integer", Num: 1, NotNull: true},
+					{Name: "customer_id", Type: "integer", Num: 2},
+					{Name: "dest_address_id", Type: "integer", Num: 3},
+					{Name: "bill_address_id", Type: "integer", Num: 4},
+				},
+				ForeignKeys: []pgschema.ForeignKey{
+					{Name: "fk_order_cust", ForeignTable: "customer", Columns: []int{2}, Foreign: []int{1}},
+					{Name: "fk_order_dest", ForeignTable: "address", Columns: []int{3}, Foreign: []int{1}},
+					{Name: "fk_order_bill", ForeignTable: "address", Columns: []int{4}, Foreign: []int{1}},
+				},
+			},
+		}
+	}
+
+	names := func(schema pgschema.Schema, table string) [][2]string {
+		var names [][2]string
+		for _, ref := range schema.References[table] {
+			names = append(names, [2]string{ref.FieldName(), ref.ReverseName()})
+		}
+		return names
+	}
+
+	t.Run("derived", func(t *testing.T) {
+		schema, err := pgschema.NewSchema(tables(), nil, pgschema.Config{})
+		require.NoError(t, err)
+
+		require.Equal(t, [][2]string{{"customer", "cust_orders"}}, names(schema, "customer"))
+		require.Equal(t, [][2]string{
+			{"dest_address", "cust_orders_by_dest_address"},
+			{"bill_address", "cust_orders_by_bill_address"},
+		}, names(schema, "address"))
+	})
+
+	t.Run("columns without suffix", func(t *testing.T) {
+		schema, err := pgschema.NewSchema([]pgschema.Table{
+			{
+				Name: "users",
+				Columns: []pgschema.Column{
+					{Name: "user_id", Type: "integer", Num: 1, NotNull: true},
+				},
+			},
+			{
+				Name: "doc",
+				Columns: []pgschema.Column{
+					{Name: "doc_id", Type: "integer", Num: 1, NotNull: true},
+					{Name: "created_by", Type: "integer", Num: 2},
+					{Name: "updated_by", Type: "integer", Num: 3},
+				},
+				ForeignKeys: []pgschema.ForeignKey{
+					{Name: "fk_doc_created", ForeignTable: "users", Columns: []int{2}, Foreign: []int{1}},
+					{Name: "fk_doc_updated", ForeignTable: "users", Columns: []int{3}, Foreign: []int{1}},
+				},
+			},
+		}, nil, pgschema.Config{})
+		require.NoError(t, err)
+
+		require.Equal(t, [][2]string{
+			{"users_by_created_by", "docs_by_created_by"},
+			{"users_by_updated_by", "docs_by_updated_by"},
+		}, names(schema, "users"))
+	})
+
+	t.Run("config", func(t *testing.T) {
+		schema, err := pgschema.NewSchema(tables(), nil, pgschema.Config{
+			Relations: map[string]pgschema.RelationName{
+				"cust_order.fk_order_cust": {Field: "buyer", Reverse: "orders"},
+				"cust_order.fk_order_dest": {Reverse: "deliveries"},
+			},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, [][2]string{{"buyer", "orders"}}, names(schema, "customer"))
+		require.Equal(t, [][2]string{
+			{"dest_address", "deliveries"},
+			{"bill_address", "cust_orders_by_bill_address"},
+		}, names(schema, "address"))
+	})
+
+	t.Run("collision", func(t *testing.T) {
+		tt := tables()
+		tt[2].ForeignKeys[0].Comment = `@name(field: "dest_address")`
+
+		_, err := pgschema.NewSchema(tt, nil, pgschema.Config{})
+		require.EqualError(t, err, `table "cust_order": field "dest_address" of foreign key "fk_order_dest" collides with foreign key "fk_order_cust"`)
+	})
+
+	t.Run("collision with column", func(t *testing.T) {
+		_, err := pgschema.NewSchema(tables(), nil, pgschema.Config{
+			Relations: map[string]pgschema.RelationName{
+				"cust_order.fk_order_bill": {Field: "order_id"},
+			},
+		})
+		require.EqualError(t, err, `table "cust_order": field "order_id" of foreign key "fk_order_bill" collides with column "order_id"`)
+	})
+
+	t.Run("unknown foreign key", func(t *testing.T) {
+		_, err := pgschema.NewSchema(tables(), nil, pgschema.Config{
+			Relations: map[string]pgschema.RelationName{
+				"cust_order.fk_order_ship": {Field: "ship"},
+			},
+		})
+		require.EqualError(t, err, `config relation "cust_order.fk_order_ship": no such foreign key`)
+	})
+}
+
+func Test_NewSchema_ReferenceAggregate(t *testing.T) {
+	schema, err := pgschema.NewSchema([]pgschema.Table{
+		{
+			Name: "cust_order",
+			Columns: []pgschema.Column{
+				{Name: "order_id", Type: "integer", Num: 1, NotNull: true},
+			},
+		},
+		{
+			Name: "order_line",
+			Columns: []pgschema.Column{
+				{Name: "order_id", Type: "integer", Num: 1, NotNull: true},
+				{Name: "price", Type: "numeric", Num: 2},
+			},
+			ForeignKeys: []pgschema.ForeignKey{
+				{Name: "fk_ol_order", ForeignTable: "cust_order", Columns: []int{1}, Foreign: []int{1}},
+			},
+		},
+	}, nil, pgschema.Config{})
+	require.NoError(t, err)
+
+	require.Len(t, schema.References["cust_order"], 1)
+
+	ref := schema.References["cust_order"][0]
+	require.Equal(t, "order_lines_aggregate", ref.AggregateName())
+	require.Equal(t, "custOrderOrderLinesAggregateLoader", ref.AggregateLoaderVar())
+	require.Equal(t,
+		`select "order_id" as "key",count(*) as "node.count",sum("price") as "node.sum.price",avg("price") as "node.avg.price",`+
+			`min("order_id") as "node.min.order_id",min("price") as "node.min.price",max("order_id") as "node.max.order_id",max("price") as "node.max.price" `+
+			`from "order_line" where 1=1 and "order_id" = any($1)`,
+		ref.AggregateSQL(),
+	)
+}
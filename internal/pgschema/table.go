@@ -7,11 +7,26 @@ import (
 	"github.com/iancoleman/strcase"
 
 	"github.com/regeda/turboql/pkg/graphqlx"
+	"github.com/regeda/turboql/pkg/sqlgen"
+)
+
+// Bits of pg_relation_is_updatable.
+const (
+	relUpdatable  = 4
+	relInsertable = 8
+	relDeletable  = 16
 )
 
 type Table struct {
-	Schema      string `db:"schemaname"`
-	Name        string `db:"tablename"`
+	Schema string `db:"schemaname"`
+	Name   string `db:"tablename"`
+	// Kind is "v" for views and "m" for materialized views.
+	Kind string `db:"relkind"`
+	// Operations is the bitmask of DML operations supported by the view.
+	Operations int `db:"updatable"`
+	// Comment is set by COMMENT ON TABLE.
+	Comment     string `db:"comment"`
+	Annotations Annotations
 	Columns     []Column
 	PrimaryKeys []PrimaryKey
 	ForeignKeys []ForeignKey
@@ -21,6 +36,37 @@ func (t Table) Title() string {
 	return strcase.ToCamel(t.Name)
 }
 
+// View reports whether the relation is a view or a materialized view.
+func (t Table) View() bool {
+	return t.Kind == "v" || t.Kind == "m"
+}
+
+// Insertable reports whether rows can be inserted, views must be auto-updatable.
+func (t Table) Insertable() bool {
+	return !t.View() || t.Operations&relInsertable != 0
+}
+
+func (t Table) Updatable() bool {
+	return !t.View() || t.Operations&relUpdatable != 0
+}
+
+func (t Table) Deletable() bool {
+	return !t.View() || t.Operations&relDeletable != 0
+}
+
+// GraphqlName returns the name of root fields of the table.
+func (t Table) GraphqlName() string {
+	return graphqlName(t.Name)
+}
+
+// Ident returns the quoted name of the table qualified by the schema.
+func (t Table) Ident() string {
+	if t.Schema == "" {
+		return sqlgen.Ident(t.Name)
+	}
+	return sqlgen.Ident(t.Schema, t.Name)
+}
+
 func (t Table) ColumnAt(i int) (Column, bool) {
 	for _, c := range t.Columns {
 		if c.Num == i {
@@ -30,6 +76,31 @@ func (t Table) ColumnAt(i int) (Column, bool) {
 	return Column{}, false
 }
 
+// PrimaryKeyColumns returns the columns of the primary key in the constraint order.
+func (t Table) PrimaryKeyColumns() []Column {
+	if len(t.PrimaryKeys) == 0 {
+		return nil
+	}
+	var cols []Column
+	for _, i := range t.PrimaryKeys[0].Columns {
+		if c, ok := t.ColumnAt(i); ok {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
+// Fields returns columns exposed by GraphQL, hidden columns are left out.
+func (t Table) Fields() []Column {
+	var cols []Column
+	for _, c := range t.Columns {
+		if !c.Annotations.Hidden {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
 func (t Table) Var() string {
 	return strcase.ToLowerCamel(t.Name)
 }
@@ -42,10 +113,30 @@ func (t Table) FilterVar() string {
 	return t.Var() + "Filter"
 }
 
+func (t Table) FilterFieldsVar() string {
+	return t.FilterVar() + "Fields"
+}
+
+func (t Table) SQLVar() string {
+	return t.Var() + "Table"
+}
+
+func (t Table) OrderByVar() string {
+	return t.Var() + "OrderBy"
+}
+
+func (t Table) ConnectionVar() string {
+	return t.Var() + "Connection"
+}
+
 func (t Table) InputVar() string {
 	return t.Var() + "Input"
 }
 
+func (t Table) InsertInputVar() string {
+	return t.Var() + "InsertInput"
+}
+
 func (t Table) GoType() string {
 	return t.Title()
 }
@@ -54,44 +145,87 @@ func (t Table) References(schema Schema) []Reference {
 	return schema.References[t.Name]
 }
 
-func (t Table) SelectSQL(ref ...string) string {
+// ForeignReferences returns references of the table to other tables.
+func (t Table) ForeignReferences(schema Schema) []Reference {
+	return schema.ForeignReferences[t.Name]
+}
+
+func (t Table) Links(schema Schema) []Link {
+	return schema.Links[t.Name]
+}
+
+// SelectSQL selects rows of the table, the ref columns are matched
+// by arrays of keys passed as a param per column.
+func (t Table) SelectSQL(ref ...Column) string {
 	b := new(bytes.Buffer)
 	b.WriteString("select ")
 	t.writeColumns(b)
 	b.WriteString(" from ")
-	b.WriteString(t.Name)
+	b.WriteString(t.FromSQL(ref...))
+	return b.String()
+}
+
+// FromSQL returns the from clause of SelectSQL starting by the table.
+func (t Table) FromSQL(ref ...Column) string {
+	b := new(bytes.Buffer)
+	b.WriteString(t.Ident())
 	b.WriteString(" where 1=1")
-	if len(ref) > 0 {
+	writeKeysMatch(b, "", ref)
+	return b.String()
+}
+
+// writeKeysMatch matches columns by arrays of keys, columns are qualified by the alias if any.
+func writeKeysMatch(b *bytes.Buffer, alias string, cols []Column) {
+	ident := func(c Column) string {
+		if alias == "" {
+			return c.Ident()
+		}
+		return alias + "." + c.Ident()
+	}
+	switch len(cols) {
+	case 0:
+	case 1:
 		b.WriteString(" and ")
-		b.WriteString(ref[0])
+		b.WriteString(ident(cols[0]))
 		b.WriteString(" = any($1)")
+	default:
+		b.WriteString(" and (")
+		for i, c := range cols {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(ident(c))
+		}
+		b.WriteString(") in (select ")
+		for i, c := range cols {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString("unnest($")
+			b.WriteString(strconv.Itoa(i + 1))
+			b.WriteString("::")
+			b.WriteString(c.Type)
+			b.WriteString("[])")
+		}
+		b.WriteByte(')')
 	}
-	return b.String()
 }
 
-func (t Table) InsertSQL() string {
-	b := new(bytes.Buffer)
-	b.WriteString("insert into ")
-	b.WriteString(t.Name)
-	b.WriteByte('(')
-	t.writeColumns(b)
-	b.WriteString(")values(")
-	for i := 1; i <= len(t.Columns); i++ {
-		if i > 1 {
-			b.WriteByte(',')
-		}
-		b.WriteByte('$')
-		b.WriteString(strconv.Itoa(i))
+func (t Table) SelectByPrimaryKeySQL() string {
+	b := bytes.NewBufferString(t.SelectSQL())
+	for i, c := range t.PrimaryKeyColumns() {
+		b.WriteString(" and ")
+		b.WriteString(c.Ident())
+		b.WriteString("=$")
+		b.WriteString(strconv.Itoa(i + 1))
 	}
-	b.WriteString(")returning ")
-	t.writeColumns(b)
 	return b.String()
 }
 
 func (t Table) DeleteSQL() string {
 	b := new(bytes.Buffer)
 	b.WriteString("delete from ")
-	b.WriteString(t.Name)
+	b.WriteString(t.Ident())
 	b.WriteString(" where 1=1")
 	return b.String()
 }
@@ -107,16 +241,16 @@ func (t Table) writeColumns(b *bytes.Buffer) {
 		if i > 0 {
 			b.WriteByte(',')
 		}
-		b.WriteString(c.Name)
+		b.WriteString(c.Ident())
 	}
 }
 
 func (t Table) GraphqlFilterArgs() []graphqlx.Arg {
 	var args []graphqlx.Arg
-	for _, c := range t.Columns {
+	for _, c := range t.Fields() {
 		if f, ok := c.FilterType(); ok {
 			args = append(args, graphqlx.Arg{
-				Name: c.Name,
+				Name: c.GraphqlName(),
 				Type: f,
 			})
 		}
@@ -124,13 +258,35 @@ func (t Table) GraphqlFilterArgs() []graphqlx.Arg {
 	return args
 }
 
+// GraphqlColumnArgs returns fields of the update input, every field is optional.
 func (t Table) GraphqlColumnArgs() []graphqlx.Arg {
 	var args []graphqlx.Arg
-	for _, c := range t.Columns {
+	for _, c := range t.Fields() {
+		if !c.Writable() {
+			continue
+		}
+		args = append(args, graphqlx.Arg{
+			Name:        c.GraphqlName(),
+			Type:        c.GraphqlType(),
+			Description: c.Description(),
+		})
+	}
+	return args
+}
+
+// GraphqlInsertArgs returns fields of the insert input,
+// only columns without defaults are required.
+func (t Table) GraphqlInsertArgs() []graphqlx.Arg {
+	var args []graphqlx.Arg
+	for _, c := range t.Fields() {
+		if !c.Writable() {
+			continue
+		}
 		args = append(args, graphqlx.Arg{
-			Name:    c.Name,
-			Type:    c.GraphqlType(),
-			NonNull: c.NotNull,
+			Name:        c.GraphqlName(),
+			Type:        c.GraphqlType(),
+			NonNull:     c.Required(),
+			Description: c.Description(),
 		})
 	}
 	return args
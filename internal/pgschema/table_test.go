@@ -0,0 +1,129 @@
+package pgschema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/internal/pgschema"
+)
+
+func Test_Table_SelectByPrimaryKeySQL(t *testing.T) {
+	table := pgschema.Table{
+		Schema: "public",
+		Name:   "book_author",
+		Columns: []pgschema.Column{
+			{Name: "book_id", Type: "integer", Num: 1, NotNull: true},
+			{Name: "author_id", Type: "integer", Num: 2, NotNull: true},
+		},
+		PrimaryKeys: []pgschema.PrimaryKey{
+			{Name: "pk_bookauthor", Columns: []int{2, 1}},
+		},
+	}
+
+	require.Equal(t,
+		`select "book_id","author_id" from "public"."book_author" where 1=1 and "author_id"=$1 and "book_id"=$2`,
+		table.SelectByPrimaryKeySQL(),
+	)
+}
+
+func Test_Table_QuotedIdentifiers(t *testing.T) {
+	table := pgschema.Table{
+		Schema: "Shop",
+		Name:   "order",
+		Columns: []pgschema.Column{
+			{Name: "id", Type: "integer", Num: 1, NotNull: true},
+			{Name: "Created At", Type: "timestamp with time zone", Num: 2},
+		},
+	}
+
+	require.Equal(t, `select "id","Created At" from "Shop"."order" where 1=1`, table.SelectSQL())
+	require.Equal(t, `delete from "Shop"."order" where 1=1`, table.DeleteSQL())
+	require.Equal(t, "Created_At", table.Columns[1].GraphqlName())
+}
+
+func Test_Table_SelectSQL_CompositeKey(t *testing.T) {
+	table := pgschema.Table{
+		Schema: "public",
+		Name:   "account",
+		Columns: []pgschema.Column{
+			{Name: "tenant_id", Type: "integer", Num: 1},
+			{Name: "id", Type: "bigint", Num: 2},
+		},
+	}
+
+	require.Equal(t,
+		`select "tenant_id","id" from "public"."account" where 1=1 and ("tenant_id","id") in (select unnest($1::integer[]),unnest($2::bigint[]))`,
+		table.SelectSQL(table.Columns...),
+	)
+}
+
+func Test_Table_Mutations(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		table  pgschema.Table
+		insert bool
+		update bool
+		delete bool
+	}{
+		{
+			name:   "table",
+			table:  pgschema.Table{Kind: "r"},
+			insert: true,
+			update: true,
+			delete: true,
+		},
+		{
+			name:  "view",
+			table: pgschema.Table{Kind: "v"},
+		},
+		{
+			name:   "updatable view",
+			table:  pgschema.Table{Kind: "v", Operations: 4 | 16},
+			update: true,
+			delete: true,
+		},
+		{
+			name:  "materialized view",
+			table: pgschema.Table{Kind: "m"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.insert, tc.table.Insertable())
+			require.Equal(t, tc.update, tc.table.Updatable())
+			require.Equal(t, tc.delete, tc.table.Deletable())
+		})
+	}
+}
+
+func Test_Table_AggregateSQL(t *testing.T) {
+	table := pgschema.Table{
+		Schema: "public",
+		Name:   "order_line",
+		Columns: []pgschema.Column{
+			{Name: "line_id", Type: "integer", Num: 1, NotNull: true},
+			{Name: "order_id", Type: "integer", Num: 2, NotNull: true},
+			{Name: "quantity", Type: "integer", Num: 3, NotNull: true},
+			{Name: "price", Type: "numeric", Num: 4},
+			{Name: "note", Type: "text", Num: 5},
+			{Name: "meta", Type: "jsonb", Num: 6},
+		},
+		PrimaryKeys: []pgschema.PrimaryKey{{Name: "pk_order_line", Columns: []int{1}}},
+		ForeignKeys: []pgschema.ForeignKey{{Name: "fk_ol_order", ForeignTable: "cust_order", Columns: []int{2}, Foreign: []int{1}}},
+	}
+
+	require.Equal(t,
+		`select count(*) as "count",sum("quantity") as "sum.quantity",sum("price") as "sum.price",avg("quantity") as "avg.quantity",avg("price") as "avg.price",`+
+			`min("line_id") as "min.line_id",min("order_id") as "min.order_id",min("quantity") as "min.quantity",min("price") as "min.price",min("note") as "min.note",`+
+			`max("line_id") as "max.line_id",max("order_id") as "max.order_id",max("quantity") as "max.quantity",max("price") as "max.price",max("note") as "max.note" `+
+			`from "public"."order_line" where 1=1`,
+		table.AggregateSQL(),
+	)
+
+	sum := table.SumColumns()
+	require.Len(t, sum, 2)
+	require.Equal(t, "bigint", sum[0].Type)
+	require.False(t, sum[0].NotNull)
+	require.Equal(t, "numeric", table.AvgColumns()[0].Type)
+	require.False(t, table.MinMaxColumns()[0].NotNull)
+}
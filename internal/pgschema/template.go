@@ -3,6 +3,8 @@ package pgschema
 import (
 	_ "embed"
 	"io"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/pkg/errors"
@@ -26,6 +28,30 @@ var funcMap = template.FuncMap{
 		}
 		return m, nil
 	},
+	"literal": literal,
+	"comment": comment,
+}
+
+// literal returns the Go string literal, the raw string is preferred
+// to keep SQL with quoted identifiers readable.
+func literal(s string) string {
+	if strings.Contains(s, `"`) && !strings.ContainsAny(s, "`\r") {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+// comment renders the text as Go line comments.
+func comment(s string) string {
+	s = strings.ReplaceAll(strings.TrimSpace(s), "\r", "")
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight("// "+l, " ")
+	}
+	return strings.Join(lines, "\n")
 }
 
 type Builder struct {
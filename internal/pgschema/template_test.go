@@ -12,6 +12,187 @@ import (
 )
 
 func Test_Builder_Execute(t *testing.T) {
+	schema, err := pgschema.NewSchema(
+		[]pgschema.Table{
+			{
+				Name:    "foobar",
+				Comment: "Foobar is described by \"COMMENT ON TABLE\".",
+				Columns: []pgschema.Column{
+					{
+						Name:    "foo",
+						Type:    "integer",
+						Num:     1,
+						NotNull: true,
+					},
+					{
+						Name:    "bar",
+						Type:    "text",
+						Num:     2,
+						NotNull: true,
+						Comment: "Bar spans\nmultiple lines.",
+					},
+					{
+						Name: "baz_ref",
+						Type: "integer",
+						Num:  3,
+					},
+				},
+				PrimaryKeys: []pgschema.PrimaryKey{
+					{
+						Name:    "pk_foobar",
+						Columns: []int{1, 2},
+					},
+				},
+				ForeignKeys: []pgschema.ForeignKey{
+					{
+						Name:         "bazquux_fk",
+						ForeignTable: "bazquux",
+						Foreign:      []int{1},
+						Columns:      []int{3},
+					},
+				},
+			},
+			{
+				Name: "bazquux",
+				Columns: []pgschema.Column{
+					{
+						Name:    "baz",
+						Type:    "integer",
+						Num:     1,
+						NotNull: true,
+					},
+					{
+						Name: "quux",
+						Type: "text",
+						Num:  2,
+					},
+					{
+						Name:    "state",
+						Type:    "quux_state",
+						Num:     3,
+						NotNull: true,
+					},
+					{
+						Name: "meta",
+						Type: "jsonb",
+						Num:  4,
+					},
+					{
+						Name:     "tags",
+						Type:     "text[]",
+						Num:      5,
+						ElemType: "text",
+					},
+					{
+						Name:    "ttl",
+						Type:    "interval",
+						Num:     6,
+						Comment: `@deprecated(reason: "use \"expires_at\"")`,
+					},
+					{
+						Name:    "addr",
+						Type:    "inet",
+						Num:     7,
+						Comment: "@hidden",
+					},
+					{
+						Name:   "email",
+						Type:   "citext",
+						Num:    8,
+						Domain: "email_address",
+						Check:  "CHECK ((VALUE ~~ '%@%'::citext))",
+					},
+				},
+				PrimaryKeys: []pgschema.PrimaryKey{
+					{
+						Name:    "pk_bazquux",
+						Columns: []int{1},
+					},
+				},
+			},
+			{
+				Name: "corge",
+				Columns: []pgschema.Column{
+					{
+						Name:    "foo_ref",
+						Type:    "integer",
+						Num:     1,
+						NotNull: true,
+					},
+					{
+						Name: "bar_ref",
+						Type: "text",
+						Num:  2,
+					},
+				},
+				ForeignKeys: []pgschema.ForeignKey{
+					{
+						Name:         "foobar_fk",
+						ForeignTable: "foobar",
+						Foreign:      []int{1, 2},
+						Columns:      []int{1, 2},
+						Comment:      `@name(field: "parent", reverse: "children")`,
+					},
+				},
+			},
+			{
+				Name: "bazquux_foobar",
+				Columns: []pgschema.Column{
+					{
+						Name:    "baz",
+						Type:    "integer",
+						Num:     1,
+						NotNull: true,
+					},
+					{
+						Name:    "foo_id",
+						Type:    "integer",
+						Num:     2,
+						NotNull: true,
+					},
+				},
+				PrimaryKeys: []pgschema.PrimaryKey{
+					{
+						Name:    "pk_bazquux_foobar",
+						Columns: []int{1, 2},
+					},
+				},
+				ForeignKeys: []pgschema.ForeignKey{
+					{
+						Name:         "baz_fk",
+						ForeignTable: "bazquux",
+						Foreign:      []int{1},
+						Columns:      []int{1},
+					},
+					{
+						Name:         "foo_fk",
+						ForeignTable: "foobar",
+						Foreign:      []int{1},
+						Columns:      []int{2},
+					},
+				},
+			},
+		},
+		[]pgschema.Enum{
+			{
+				Schema: "public",
+				Name:   "quux_state",
+				Type:   "quux_state",
+				Values: []string{"new", "in progress", "done"},
+			},
+		},
+		pgschema.Config{
+			Columns: map[string]pgschema.TypeOverride{
+				"bazquux.quux": {
+					GoType:      pgschema.TypeRef{Package: "github.com/google/uuid", Name: "UUID"},
+					GraphqlType: pgschema.TypeRef{Package: "example.com/api/scalars", Name: "UUID"},
+				},
+			},
+		},
+	)
+
+	require.NoError(t, err)
+
 	buf := new(bytes.Buffer)
 
 	b, err := pgschema.NewBuilder(buf)
@@ -22,65 +203,7 @@ func Test_Builder_Execute(t *testing.T) {
 		Package: pgschema.Package{
 			Name: "pgschema_test",
 		},
-		Schema: pgschema.NewSchema(
-			[]pgschema.Table{
-				{
-					Name: "foobar",
-					Columns: []pgschema.Column{
-						{
-							Name: "foo",
-							Type: "integer",
-							Num:  1,
-						},
-						{
-							Name: "bar",
-							Type: "text",
-							Num:  2,
-						},
-						{
-							Name: "baz_ref",
-							Type: "integer",
-							Num:  3,
-						},
-					},
-					PrimaryKeys: []pgschema.PrimaryKey{
-						{
-							Name:    "pk_foobar",
-							Columns: []int{1, 2},
-						},
-					},
-					ForeignKeys: []pgschema.ForeignKey{
-						{
-							Name:         "bazquux_fk",
-							ForeignTable: "bazquux",
-							Foreign:      []int{1},
-							Columns:      []int{3},
-						},
-					},
-				},
-				{
-					Name: "bazquux",
-					Columns: []pgschema.Column{
-						{
-							Name: "baz",
-							Type: "integer",
-							Num:  1,
-						},
-						{
-							Name: "quux",
-							Type: "text",
-							Num:  2,
-						},
-					},
-					PrimaryKeys: []pgschema.PrimaryKey{
-						{
-							Name:    "pk_bazquux",
-							Columns: []int{1},
-						},
-					},
-				},
-			},
-		),
+		Schema: schema,
 	})
 
 	require.NoError(t, err)
@@ -2,35 +2,143 @@ package batcher
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"sync"
 
 	"github.com/graph-gophers/dataloader/v7"
 	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
 	"github.com/stephenafamo/scan"
 	"github.com/stephenafamo/scan/pgxscan"
+
+	"github.com/regeda/turboql/pkg/graphqlx/filter"
+	"github.com/regeda/turboql/pkg/sqlgen"
 )
 
-type QueryResolver func(graphql.ResolveParams) (string, []any)
+// QueryResolver renders the query of the field, the error fails the field before querying.
+type QueryResolver func(graphql.ResolveParams) (string, []any, error)
 
 func GraphqlOne[V any](pq pgxscan.Queryer, query QueryResolver) graphql.FieldResolveFn {
 	mapper := scan.StructMapper[V]()
 	return func(p graphql.ResolveParams) (any, error) {
-		sql, args := query(p)
-		return pgxscan.One(p.Context, pq, mapper, sql, args...)
+		q, args, err := query(p)
+		if err != nil {
+			return nil, err
+		}
+		v, err := pgxscan.One(p.Context, pq, mapper, q, args...)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return v, err
 	}
 }
 
 func GraphqlAll[V any](pq pgxscan.Queryer, query QueryResolver) graphql.FieldResolveFn {
 	mapper := scan.StructMapper[V]()
 	return func(p graphql.ResolveParams) (any, error) {
-		sql, args := query(p)
+		sql, args, err := query(p)
+		if err != nil {
+			return nil, err
+		}
 		return pgxscan.All(p.Context, pq, mapper, sql, args...)
 	}
 }
 
+// GraphqlConnection resolves a page of the Relay connection,
+// the value func returns the column value of the node to make its cursor.
+func GraphqlConnection[V any](pq pgxscan.Queryer, t *sqlgen.Table, base string, pk []string, value func(V, string) any) graphql.FieldResolveFn {
+	mapper := scan.StructMapper[V]()
+	return func(p graphql.ResolveParams) (any, error) {
+		q, args, page, err := filter.PageSQL(t, base, nil, pk, p)
+		if err != nil {
+			return nil, err
+		}
+		data, err := pgxscan.All(p.Context, pq, mapper, q, args...)
+		if err != nil {
+			return nil, err
+		}
+		conn := &filter.Connection{
+			TotalCount: func(ctx context.Context) (int, error) {
+				return pgxscan.One(ctx, pq, scan.SingleColumnMapper[int], page.CountSQL, page.CountArgs...)
+			},
+		}
+		if page.Limit >= 0 && len(data) > page.Limit {
+			data = data[:page.Limit]
+			if page.Backward {
+				conn.HasPreviousPage = true
+			} else {
+				conn.HasNextPage = true
+			}
+		}
+		if page.Backward {
+			slices.Reverse(data)
+		}
+		conn.Edges = make([]filter.Edge, len(data))
+		for i, v := range data {
+			values := make([]any, len(page.Order))
+			for j, o := range page.Order {
+				values[j] = value(v, o.Column)
+			}
+			cursor, err := filter.EncodeCursor(values)
+			if err != nil {
+				return nil, err
+			}
+			conn.Edges[i] = filter.Edge{Cursor: cursor, Node: v}
+		}
+		return conn, nil
+	}
+}
+
+// CompositeKey is the key of multiple columns,
+// Values returns column values in the order of query params.
+type CompositeKey interface {
+	Values() []any
+}
+
+// keysArgs passes keys as the array param,
+// composite keys are passed as an array per column.
+func keysArgs[K comparable](keys []K) ([]any, error) {
+	if len(keys) == 0 {
+		return []any{keys}, nil
+	}
+	first, ok := any(keys[0]).(CompositeKey)
+	if !ok {
+		return []any{keys}, nil
+	}
+	columns := make([][]any, len(first.Values()))
+	for i := range columns {
+		columns[i] = make([]any, len(keys))
+	}
+	for j, k := range keys {
+		ck, ok := any(k).(CompositeKey)
+		if !ok {
+			return nil, errors.Errorf("key %v is not composite", k)
+		}
+		values := ck.Values()
+		if len(values) != len(columns) {
+			return nil, errors.Errorf("key %v has %d columns, expected %d", k, len(values), len(columns))
+		}
+		for i, v := range values {
+			columns[i][j] = v
+		}
+	}
+	args := make([]any, len(columns))
+	for i, c := range columns {
+		args[i] = c
+	}
+	return args, nil
+}
+
 func NewLoader[K comparable, V any](pq pgxscan.Queryer, indexer func(V) K, query string) *dataloader.Loader[K, V] {
 	mapper := scan.StructMapper[V]()
 	return dataloader.NewBatchedLoader(func(ctx context.Context, keys []K) []*dataloader.Result[V] {
-		data, err := pgxscan.All(ctx, pq, mapper, query, keys)
+		args, err := keysArgs(keys)
+		if err != nil {
+			return errToResult[K, V](keys, err)
+		}
+		data, err := pgxscan.All(ctx, pq, mapper, query, args...)
 		if err != nil {
 			return errToResult[K, V](keys, err)
 		}
@@ -45,7 +153,11 @@ func NewLoader[K comparable, V any](pq pgxscan.Queryer, indexer func(V) K, query
 func NewListLoader[K comparable, V any](pq pgxscan.Queryer, indexer func(V) K, query string) *dataloader.Loader[K, []V] {
 	mapper := scan.StructMapper[V]()
 	return dataloader.NewBatchedLoader(func(ctx context.Context, keys []K) []*dataloader.Result[[]V] {
-		data, err := pgxscan.All(ctx, pq, mapper, query, keys)
+		args, err := keysArgs(keys)
+		if err != nil {
+			return errToResult[K, []V](keys, err)
+		}
+		data, err := pgxscan.All(ctx, pq, mapper, query, args...)
 		if err != nil {
 			return errToResult[K, []V](keys, err)
 		}
@@ -58,6 +170,147 @@ func NewListLoader[K comparable, V any](pq pgxscan.Queryer, indexer func(V) K, q
 	}, dataloader.WithClearCacheOnBatch[K, []V]())
 }
 
+// ArgsLoader loads values of the field with arguments,
+// keys requested with the same arguments are loaded by a single query.
+type ArgsLoader[K comparable, R any] struct {
+	query ArgsQueryResolver
+	load  func(ctx context.Context, q string, args []any) (map[K]R, error)
+	zero  func() R
+
+	mu sync.Mutex
+	// groups are loaders of pending batches keyed by JSON of arguments.
+	groups map[string]*dataloader.Loader[K, R]
+}
+
+// ArgsQueryResolver renders the query of the arguments, keys are passed as args,
+// the error fails all keys of the batch before querying.
+type ArgsQueryResolver func(p graphql.ResolveParams, args []any) (string, []any, error)
+
+// newArgsLoader groups keys by arguments, load returns values of keys of the group,
+// missing keys are resolved by the zero func.
+func newArgsLoader[K comparable, R any](query ArgsQueryResolver, load func(ctx context.Context, q string, args []any) (map[K]R, error), zero func() R) *ArgsLoader[K, R] {
+	return &ArgsLoader[K, R]{
+		query:  query,
+		load:   load,
+		zero:   zero,
+		groups: make(map[string]*dataloader.Loader[K, R]),
+	}
+}
+
+// group makes the loader of keys requested with the arguments of p,
+// the loader leaves groups once its batch is dispatched.
+func (l *ArgsLoader[K, R]) group(args string, p graphql.ResolveParams) *dataloader.Loader[K, R] {
+	var loader *dataloader.Loader[K, R]
+	loader = dataloader.NewBatchedLoader(func(ctx context.Context, keys []K) []*dataloader.Result[R] {
+		l.mu.Lock()
+		if l.groups[args] == loader {
+			delete(l.groups, args)
+		}
+		l.mu.Unlock()
+
+		kargs, err := keysArgs(keys)
+		if err != nil {
+			return errToResult[K, R](keys, err)
+		}
+		q, qargs, err := l.query(p, kargs)
+		if err != nil {
+			return errToResult[K, R](keys, err)
+		}
+		mm, err := l.load(ctx, q, qargs)
+		r := make([]*dataloader.Result[R], len(keys))
+		for i, k := range keys {
+			v, ok := mm[k]
+			if !ok && err == nil {
+				v = l.zero()
+			}
+			r[i] = &dataloader.Result[R]{Data: v, Error: err}
+		}
+		return r
+	}, dataloader.WithClearCacheOnBatch[K, R]())
+	return loader
+}
+
+func NewListArgsLoader[K comparable, V any](pq pgxscan.Queryer, indexer func(V) K, query ArgsQueryResolver) *ArgsLoader[K, []V] {
+	mapper := scan.StructMapper[V]()
+	return newArgsLoader(query, func(ctx context.Context, q string, args []any) (map[K][]V, error) {
+		data, err := pgxscan.All(ctx, pq, mapper, q, args...)
+		if err != nil {
+			return nil, err
+		}
+		mm := make(map[K][]V)
+		for _, v := range data {
+			id := indexer(v)
+			mm[id] = append(mm[id], v)
+		}
+		return mm, nil
+	}, func() []V { return nil })
+}
+
+// NewAggregateLoader loads aggregates grouped by keys,
+// the query selects the key as "key" and aggregates prefixed by "node.".
+// Keys without rows get zero aggregates.
+func NewAggregateLoader[K comparable, V any](pq pgxscan.Queryer, query ArgsQueryResolver) *ArgsLoader[K, *V] {
+	mapper := scan.StructMapper[link[K, *V]]()
+	return newArgsLoader(query, func(ctx context.Context, q string, args []any) (map[K]*V, error) {
+		data, err := pgxscan.All(ctx, pq, mapper, q, args...)
+		if err != nil {
+			return nil, err
+		}
+		mm := make(map[K]*V, len(data))
+		for _, l := range data {
+			mm[l.Key] = l.Node
+		}
+		return mm, nil
+	}, func() *V { return new(V) })
+}
+
+// Load requests the value of the key with arguments of the field.
+func (l *ArgsLoader[K, R]) Load(p graphql.ResolveParams, key K) dataloader.Thunk[R] {
+	b, err := json.Marshal(p.Args)
+	if err != nil {
+		return func() (R, error) {
+			var zero R
+			return zero, errors.WithMessage(err, "group arguments")
+		}
+	}
+	args := string(b)
+	l.mu.Lock()
+	loader, ok := l.groups[args]
+	if !ok {
+		loader = l.group(args, p)
+		l.groups[args] = loader
+	}
+	l.mu.Unlock()
+	return loader.Load(p.Context, key)
+}
+
+// link is the node of the many-to-many relationship keyed by the row of the link table.
+type link[K comparable, V any] struct {
+	Key  K `db:"key"`
+	Node V `db:"node"`
+}
+
+// NewLinkLoader loads nodes through the link table,
+// the query selects the key as "key" and columns of nodes prefixed by "node.".
+func NewLinkLoader[K comparable, V any](pq pgxscan.Queryer, query string) *dataloader.Loader[K, []V] {
+	mapper := scan.StructMapper[link[K, V]]()
+	return dataloader.NewBatchedLoader(func(ctx context.Context, keys []K) []*dataloader.Result[[]V] {
+		args, err := keysArgs(keys)
+		if err != nil {
+			return errToResult[K, []V](keys, err)
+		}
+		data, err := pgxscan.All(ctx, pq, mapper, query, args...)
+		if err != nil {
+			return errToResult[K, []V](keys, err)
+		}
+		mm := make(map[K][]V, len(data))
+		for _, l := range data {
+			mm[l.Key] = append(mm[l.Key], l.Node)
+		}
+		return mapToResult(keys, mm)
+	}, dataloader.WithClearCacheOnBatch[K, []V]())
+}
+
 func mapToResult[K comparable, V any](keys []K, m map[K]V) []*dataloader.Result[V] {
 	r := make([]*dataloader.Result[V], len(keys))
 	for i, k := range keys {
@@ -0,0 +1,149 @@
+package batcher_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/batcher"
+)
+
+type row struct {
+	ParentID int `db:"parent_id"`
+}
+
+// queryer returns a row per key of the query and records queries.
+type queryer struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+func (q *queryer) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queries = append(q.queries, fmt.Sprintf("%s %v", sql, args))
+	var data [][]any
+	for _, k := range args[0].([]int) {
+		data = append(data, []any{k})
+	}
+	return &rows{cols: []string{"parent_id"}, data: data}, nil
+}
+
+type rows struct {
+	cols []string
+	data [][]any
+	i    int
+}
+
+func (r *rows) Close()                        {}
+func (r *rows) Err() error                    { return nil }
+func (r *rows) CommandTag() pgconn.CommandTag { return pgconn.CommandTag{} }
+func (r *rows) Next() bool                    { r.i++; return r.i <= len(r.data) }
+func (r *rows) Values() ([]any, error)        { return r.data[r.i-1], nil }
+func (r *rows) RawValues() [][]byte           { return nil }
+func (r *rows) Conn() *pgx.Conn               { return nil }
+
+func (r *rows) FieldDescriptions() []pgconn.FieldDescription {
+	fd := make([]pgconn.FieldDescription, len(r.cols))
+	for i, c := range r.cols {
+		fd[i] = pgconn.FieldDescription{Name: c}
+	}
+	return fd
+}
+
+func (r *rows) Scan(dest ...any) error {
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.data[r.i-1][i]))
+	}
+	return nil
+}
+
+func Test_ArgsLoader_Load(t *testing.T) {
+	pq := new(queryer)
+	loader := batcher.NewListArgsLoader(pq, func(v row) int {
+		return v.ParentID
+	}, func(p graphql.ResolveParams, args []any) (string, []any, error) {
+		return fmt.Sprintf("limit %v", p.Args["limit"]), args, nil
+	})
+
+	params := func(limit int) graphql.ResolveParams {
+		return graphql.ResolveParams{
+			Context: context.Background(),
+			Args:    map[string]any{"limit": limit},
+		}
+	}
+
+	thunks := []func() ([]row, error){
+		loader.Load(params(1), 1),
+		loader.Load(params(1), 2),
+		loader.Load(params(2), 1),
+		loader.Load(params(1), 1),
+	}
+
+	var loaded [][]row
+	for _, thunk := range thunks {
+		rows, err := thunk()
+		require.NoError(t, err)
+		loaded = append(loaded, rows)
+	}
+
+	require.Equal(t, [][]row{{{1}}, {{2}}, {{1}}, {{1}}}, loaded)
+
+	sort.Strings(pq.queries)
+	require.Equal(t, []string{
+		"limit 1 [[1 2]]",
+		"limit 2 [[1]]",
+	}, pq.queries)
+}
+
+func Test_ArgsLoader_Load_InvalidArgs(t *testing.T) {
+	pq := new(queryer)
+	loader := batcher.NewListArgsLoader(pq, func(v row) int {
+		return v.ParentID
+	}, func(p graphql.ResolveParams, args []any) (string, []any, error) {
+		return "", args, nil
+	})
+
+	_, err := loader.Load(graphql.ResolveParams{
+		Context: context.Background(),
+		Args:    map[string]any{"limit": func() {}},
+	}, 1)()
+
+	require.ErrorContains(t, err, "group arguments")
+	require.Empty(t, pq.queries)
+}
+
+type pair struct {
+	A, B int
+}
+
+func (k pair) Values() []any {
+	return []any{k.A, k.B}
+}
+
+func Test_Loader_MixedCompositeKeys(t *testing.T) {
+	pq := new(queryer)
+	loader := batcher.NewLoader(pq, func(v row) any {
+		return v.ParentID
+	}, "select")
+
+	ctx := context.Background()
+	thunks := []func() (row, error){
+		loader.Load(ctx, pair{1, 2}),
+		loader.Load(ctx, 3),
+	}
+
+	for _, thunk := range thunks {
+		_, err := thunk()
+		require.EqualError(t, err, "key 3 is not composite")
+	}
+	require.Empty(t, pq.queries)
+}
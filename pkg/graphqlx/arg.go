@@ -1,7 +1,8 @@
 package graphqlx
 
 type Arg struct {
-	Name    string
-	Type    string
-	NonNull bool
+	Name        string
+	Type        string
+	NonNull     bool
+	Description string
 }
@@ -1,49 +1,485 @@
 package filter
 
-import "github.com/graphql-go/graphql"
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"reflect"
+	"strconv"
 
-var (
-	String = scalarInputFilter(graphql.String)
-	Int    = scalarInputFilter(graphql.Int)
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+	"github.com/regeda/turboql/pkg/sqlgen"
 )
 
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// DefaultPageSize limits connections requested without first and last,
+// negative means no limit.
+var DefaultPageSize = 100
+
+// NewArgumentConfig creates the filter of columns,
+// filters are combined recursively by _and, _or and _not fields.
 func NewArgumentConfig(name string, filter graphql.InputObjectConfigFieldMap) *graphql.ArgumentConfig {
-	return &graphql.ArgumentConfig{
-		Type: graphql.NewInputObject(graphql.InputObjectConfig{
-			Name:   name,
-			Fields: filter,
+	var in *graphql.InputObject
+	in = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: name,
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			filter["_and"] = &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewList(graphql.NewNonNull(in)),
+				Description: "All filters must match.",
+			}
+			filter["_or"] = &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewList(graphql.NewNonNull(in)),
+				Description: "At least one filter must match.",
+			}
+			filter["_not"] = &graphql.InputObjectFieldConfig{
+				Type:        in,
+				Description: "The filter must not match.",
+			}
+			return filter
 		}),
+	})
+	return &graphql.ArgumentConfig{
+		Type: in,
 	}
 }
 
-func NewCursorInput(filter *graphql.ArgumentConfig) graphql.FieldConfigArgument {
+func NewCursorInput(filter, orderBy *graphql.ArgumentConfig) graphql.FieldConfigArgument {
 	return graphql.FieldConfigArgument{
-		"filter": filter,
+		"filter":   filter,
+		"order_by": orderBy,
 		"limit": &graphql.ArgumentConfig{
 			Type: graphql.Int,
 		},
+		"offset": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+	}
+}
+
+// NewConnectionInput creates arguments of the Relay connection,
+// a page is requested either by first/after or last/before.
+func NewConnectionInput(filter, orderBy *graphql.ArgumentConfig) graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		"filter":   filter,
+		"order_by": orderBy,
+		"first": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+		"after": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"last": &graphql.ArgumentConfig{
+			Type: graphql.Int,
+		},
+		"before": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+	}
+}
+
+var PageInfo = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"hasNextPage": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+			Resolve: resolveConnection(func(c *Connection) (any, error) {
+				return c.HasNextPage, nil
+			}),
+		},
+		"hasPreviousPage": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+			Resolve: resolveConnection(func(c *Connection) (any, error) {
+				return c.HasPreviousPage, nil
+			}),
+		},
+		"startCursor": &graphql.Field{
+			Type: graphql.String,
+			Resolve: resolveConnection(func(c *Connection) (any, error) {
+				if len(c.Edges) > 0 {
+					return c.Edges[0].Cursor, nil
+				}
+				return nil, nil
+			}),
+		},
+		"endCursor": &graphql.Field{
+			Type: graphql.String,
+			Resolve: resolveConnection(func(c *Connection) (any, error) {
+				if len(c.Edges) > 0 {
+					return c.Edges[len(c.Edges)-1].Cursor, nil
+				}
+				return nil, nil
+			}),
+		},
+	},
+})
+
+// resolveConnection resolves the field of the connection source.
+func resolveConnection(fn func(*Connection) (any, error)) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		c, ok := p.Source.(*Connection)
+		if !ok {
+			return nil, errors.Errorf("unexpected connection source %T", p.Source)
+		}
+		return fn(c)
+	}
+}
+
+// resolveEdge resolves the field of the edge source.
+func resolveEdge(fn func(Edge) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		e, ok := p.Source.(Edge)
+		if !ok {
+			return nil, errors.Errorf("unexpected edge source %T", p.Source)
+		}
+		return fn(e), nil
 	}
 }
 
-func scalarInputFilter(in graphql.Input) *graphql.InputObject {
-	return graphql.NewInputObject(graphql.InputObjectConfig{
-		Name: in.Name() + "Filter",
-		Fields: graphql.InputObjectConfigFieldMap{
-			"eq": &graphql.InputObjectFieldConfig{
-				Type: in,
+// NewConnection creates the connection type and the edge type of the node.
+func NewConnection(node *graphql.Object) *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: node.Name() + "Edge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: resolveEdge(func(e Edge) any {
+					return e.Cursor
+				}),
 			},
-			"gt": &graphql.InputObjectFieldConfig{
-				Type: in,
+			"node": &graphql.Field{
+				Type: graphql.NewNonNull(node),
+				Resolve: resolveEdge(func(e Edge) any {
+					return e.Node
+				}),
 			},
-			"lt": &graphql.InputObjectFieldConfig{
-				Type: in,
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: node.Name() + "Connection",
+		Fields: graphql.Fields{
+			"edges": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge))),
+				Resolve: resolveConnection(func(c *Connection) (any, error) {
+					return c.Edges, nil
+				}),
 			},
-			"gte": &graphql.InputObjectFieldConfig{
-				Type: in,
+			"pageInfo": &graphql.Field{
+				Type: graphql.NewNonNull(PageInfo),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source, nil
+				},
 			},
-			"lte": &graphql.InputObjectFieldConfig{
-				Type: in,
+			"totalCount": &graphql.Field{
+				Type: graphql.NewNonNull(scalar.BigInt),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					c, ok := p.Source.(*Connection)
+					if !ok {
+						return nil, errors.Errorf("unexpected connection source %T", p.Source)
+					}
+					return c.TotalCount(p.Context)
+				},
 			},
 		},
 	})
 }
+
+// Connection is a page of nodes, the total count is queried on demand only.
+type Connection struct {
+	Edges           []Edge
+	HasNextPage     bool
+	HasPreviousPage bool
+	TotalCount      func(context.Context) (int, error)
+}
+
+type Edge struct {
+	Cursor string
+	Node   any
+}
+
+// Page describes the query of a connection page.
+type Page struct {
+	// Order is the requested ordering completed by the primary key.
+	Order []Order
+	// Limit is the number of requested nodes, negative means no limit.
+	// The query reads one extra row to detect the next page.
+	Limit int
+	// Backward is true when the page is read by last/before,
+	// the query returns nodes in the reversed order then.
+	Backward bool
+	// CountSQL counts all nodes matching the filter.
+	CountSQL  string
+	CountArgs []any
+}
+
+// PageSQL renders the query of the requested connection page.
+// The primary key completes the ordering to make cursors unique,
+// cursors are compared by the keyset of ordered columns instead of offsets.
+func PageSQL(t *sqlgen.Table, base string, args []any, pk []string, p graphql.ResolveParams) (string, []any, Page, error) {
+	page := Page{Limit: DefaultPageSize}
+
+	first, hasFirst := p.Args["first"].(int)
+	last, hasLast := p.Args["last"].(int)
+	switch {
+	case hasFirst && hasLast:
+		return "", nil, page, errors.New("first and last must not be used together")
+	case first < 0 || last < 0:
+		return "", nil, page, errors.New("first and last must not be negative")
+	case hasLast:
+		page.Limit = last
+		page.Backward = true
+	case hasFirst:
+		page.Limit = first
+	}
+
+	order, err := completeOrder(t, parseOrderBy(t, p), pk)
+	if err != nil {
+		return "", nil, page, err
+	}
+	page.Order = order
+
+	q := bytes.NewBufferString(base)
+	args, err = writeFilter(q, t, args, p)
+	if err != nil {
+		return "", nil, page, err
+	}
+
+	page.CountSQL = "select count(*) from (" + q.String() + ") t"
+	page.CountArgs = args
+
+	for _, c := range []struct {
+		name    string
+		reverse bool
+	}{
+		{name: "after"},
+		{name: "before", reverse: true},
+	} {
+		cursor, ok := p.Args[c.name].(string)
+		if !ok {
+			continue
+		}
+		values, err := DecodeCursor(cursor, len(page.Order))
+		if err != nil {
+			return "", nil, page, errors.WithMessage(err, c.name)
+		}
+		args = writeKeyset(q, args, page.Order, values, pk, c.reverse)
+	}
+
+	order = page.Order
+	if page.Backward {
+		order = make([]Order, len(page.Order))
+		for i, o := range page.Order {
+			order[i] = o.Reverse()
+		}
+	}
+	writeOrderBy(q, order)
+
+	if page.Limit >= 0 {
+		q.WriteString(" limit ")
+		q.WriteString(strconv.Itoa(page.Limit + 1))
+	}
+
+	return q.String(), args, page, nil
+}
+
+// completeOrder appends the primary key columns missing in the ordering.
+func completeOrder(t *sqlgen.Table, order []Order, pk []string) ([]Order, error) {
+	for _, c := range pk {
+		if hasOrder(order, c) {
+			continue
+		}
+		ident, ok := t.Column(c)
+		if !ok {
+			return nil, errors.Errorf("unknown primary key column %q", c)
+		}
+		order = append(order, Order{Column: c, ident: ident})
+	}
+	return order, nil
+}
+
+func hasOrder(order []Order, column string) bool {
+	for _, o := range order {
+		if o.Column == column {
+			return true
+		}
+	}
+	return false
+}
+
+// writeKeyset renders the condition of rows placed after the cursor values,
+// or before them when the order is reversed.
+func writeKeyset(q *bytes.Buffer, args []any, order []Order, values []any, pk []string, reverse bool) []any {
+	params := make([]string, len(values))
+	for i, v := range values {
+		if v != nil {
+			args = append(args, v)
+			params[i] = "$" + strconv.Itoa(len(args))
+		}
+	}
+
+	if rowComparable(order, params, pk) {
+		// (a,b)>($1,$2) is served by the index of the primary key
+		q.WriteString(" and (")
+		for i, o := range order {
+			if i > 0 {
+				q.WriteByte(',')
+			}
+			q.WriteString(o.ident)
+		}
+		q.WriteByte(')')
+		q.WriteString(keysetOp(order[0], reverse))
+		q.WriteByte('(')
+		for i, param := range params {
+			if i > 0 {
+				q.WriteByte(',')
+			}
+			q.WriteString(param)
+		}
+		q.WriteByte(')')
+		return args
+	}
+
+	// (a>$1) or (a=$1 and b>$2) supports mixed directions and nulls
+	q.WriteString(" and (")
+	terms := 0
+	for i, o := range order {
+		next := keysetNext(o, params[i], reverse, contains(pk, o.Column))
+		if next == "" {
+			continue
+		}
+		if terms > 0 {
+			q.WriteString(" or ")
+		}
+		q.WriteByte('(')
+		for j := 0; j < i; j++ {
+			q.WriteString(order[j].ident)
+			if params[j] == "" {
+				q.WriteString(" is null")
+			} else {
+				q.WriteByte('=')
+				q.WriteString(params[j])
+			}
+			q.WriteString(" and ")
+		}
+		q.WriteString(next)
+		q.WriteByte(')')
+		terms++
+	}
+	if terms == 0 {
+		q.WriteString("false")
+	}
+	q.WriteByte(')')
+	return args
+}
+
+// rowComparable reports whether the keyset consists of not null primary key columns sorted in the same direction.
+func rowComparable(order []Order, params []string, pk []string) bool {
+	for i, o := range order {
+		if params[i] == "" || o.Desc != order[0].Desc || !contains(pk, o.Column) {
+			return false
+		}
+	}
+	return true
+}
+
+func keysetOp(o Order, reverse bool) string {
+	if o.Desc != reverse {
+		return "<"
+	}
+	return ">"
+}
+
+// keysetNext renders the condition of column values placed after the param,
+// the empty string means no value can be placed after.
+func keysetNext(o Order, param string, reverse, notNull bool) string {
+	if reverse {
+		o = o.Reverse()
+	}
+	if param == "" {
+		if o.NullsFirst {
+			return o.ident + " is not null"
+		}
+		return ""
+	}
+	next := o.ident + keysetOp(o, false) + param
+	if o.NullsFirst || notNull {
+		return next
+	}
+	return "(" + next + " or " + o.ident + " is null)"
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// EncodeCursor makes an opaque cursor of the ordered column values,
+// values of database types are encoded by their text representation.
+func EncodeCursor(values []any) (string, error) {
+	encoded := make([]any, len(values))
+	for i, v := range values {
+		text, err := cursorValue(v)
+		if err != nil {
+			return "", err
+		}
+		encoded[i] = text
+	}
+	b, err := json.Marshal(encoded)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// cursorValue returns the value in the text representation accepted by the column,
+// JSON would encode bytes and MAC addresses by base64.
+func cursorValue(v any) (any, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		v = rv.Elem().Interface()
+	}
+	switch v := v.(type) {
+	case []byte:
+		return `\x` + hex.EncodeToString(v), nil
+	case net.HardwareAddr:
+		return v.String(), nil
+	}
+	return v, nil
+}
+
+// DecodeCursor parses column values of the cursor, numbers are decoded as strings
+// to let Postgres cast them to the column type without precision loss.
+func DecodeCursor(cursor string, n int) ([]any, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var values []any
+	if err := d.Decode(&values); err != nil || len(values) != n {
+		return nil, ErrInvalidCursor
+	}
+	for i, v := range values {
+		if num, ok := v.(json.Number); ok {
+			values[i] = num.String()
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,148 @@
+package filter_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/filter"
+)
+
+func Test_Filter_PageSQL(t *testing.T) {
+	cursor := func(values ...any) string {
+		c, err := filter.EncodeCursor(values)
+		require.NoError(t, err)
+		return c
+	}
+
+	cases := []struct {
+		name          string
+		args          map[string]any
+		expectedSQL   string
+		expectedArgs  []any
+		expectedLimit int
+	}{
+		{
+			name:          "no arguments",
+			expectedSQL:   "select order by \"id\" asc limit 101",
+			expectedLimit: filter.DefaultPageSize,
+		},
+		{
+			name: "first",
+			args: map[string]any{
+				"first": 10,
+			},
+			expectedSQL:   "select order by \"id\" asc limit 11",
+			expectedLimit: 10,
+		},
+		{
+			name: "first after the primary key",
+			args: map[string]any{
+				"first": 10,
+				"after": cursor(5),
+			},
+			expectedSQL:   "select and (\"id\")>($1) order by \"id\" asc limit 11",
+			expectedArgs:  []any{"5"},
+			expectedLimit: 10,
+		},
+		{
+			name: "last before the primary key",
+			args: map[string]any{
+				"last":   10,
+				"before": cursor(5),
+			},
+			expectedSQL:   "select and (\"id\")<($1) order by \"id\" desc limit 11",
+			expectedArgs:  []any{"5"},
+			expectedLimit: 10,
+		},
+		{
+			name: "first after the ordered column",
+			args: map[string]any{
+				"first": 10,
+				"after": cursor("foo", 5),
+				"order_by": []any{
+					map[string]any{
+						"title": "desc",
+					},
+				},
+			},
+			expectedSQL:   "select and ((\"title\"<$1) or (\"title\"=$1 and \"id\">$2)) order by \"title\" desc,\"id\" asc limit 11",
+			expectedArgs:  []any{"foo", "5"},
+			expectedLimit: 10,
+		},
+		{
+			name: "first after the null value",
+			args: map[string]any{
+				"first": 10,
+				"after": cursor(nil, 5),
+				"order_by": []any{
+					map[string]any{
+						"title": "asc",
+					},
+				},
+			},
+			expectedSQL:   "select and ((\"title\" is null and \"id\">$1)) order by \"title\" asc,\"id\" asc limit 11",
+			expectedArgs:  []any{"5"},
+			expectedLimit: 10,
+		},
+		{
+			name: "last before the value of nulls last",
+			args: map[string]any{
+				"last":   10,
+				"before": cursor("foo", 5),
+				"order_by": []any{
+					map[string]any{
+						"title": "asc",
+					},
+				},
+			},
+			expectedSQL:   "select and ((\"title\"<$1) or (\"title\"=$1 and \"id\"<$2)) order by \"title\" desc,\"id\" desc limit 11",
+			expectedArgs:  []any{"foo", "5"},
+			expectedLimit: 10,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sql, args, page, err := filter.PageSQL(table, "select", nil, []string{"id"}, graphql.ResolveParams{
+				Args: c.args,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, c.expectedSQL, sql)
+			require.Equal(t, c.expectedArgs, args)
+			require.Equal(t, c.expectedLimit, page.Limit)
+			require.Equal(t, "select count(*) from (select) t", page.CountSQL)
+		})
+	}
+}
+
+func Test_Filter_PageSQL_InvalidCursor(t *testing.T) {
+	_, _, _, err := filter.PageSQL(table, "select", nil, []string{"id"}, graphql.ResolveParams{
+		Args: map[string]any{
+			"after": "foo",
+		},
+	})
+
+	require.ErrorIs(t, err, filter.ErrInvalidCursor)
+}
+
+func Test_Filter_EncodeCursor_Valuer(t *testing.T) {
+	c, err := filter.EncodeCursor([]any{
+		pgtype.Time{Microseconds: 3600 * 1e6, Valid: true},
+		pgtype.Interval{Days: 1, Valid: true},
+		1,
+		[]byte{0xde, 0xad},
+		&[]byte{0xbe, 0xef},
+		(*[]byte)(nil),
+		net.HardwareAddr{0x08, 0x00, 0x2b, 0x01, 0x02, 0x03},
+	})
+	require.NoError(t, err)
+
+	values, err := filter.DecodeCursor(c, 7)
+	require.NoError(t, err)
+	require.Equal(t, []any{"01:00:00.000000", "1 day 00:00:00", "1", `\xdead`, `\xbeef`, nil, "08:00:2b:01:02:03"}, values)
+}
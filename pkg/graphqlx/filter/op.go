@@ -0,0 +1,204 @@
+package filter
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+var (
+	String    = newScalarFilter(graphql.String, equalityOps, comparisonOps, textOps)
+	Int       = newScalarFilter(graphql.Int, equalityOps, comparisonOps)
+	Boolean   = newScalarFilter(graphql.Boolean, booleanOps)
+	Date      = newScalarFilter(scalar.Date, equalityOps, comparisonOps)
+	DateTime  = newScalarFilter(graphql.DateTime, equalityOps, comparisonOps)
+	Numeric   = newScalarFilter(scalar.Numeric, equalityOps, comparisonOps)
+	Float     = newScalarFilter(graphql.Float, equalityOps, comparisonOps)
+	BigInt    = newScalarFilter(scalar.BigInt, equalityOps, comparisonOps)
+	Time      = newScalarFilter(scalar.Time, equalityOps, comparisonOps)
+	Interval  = newScalarFilter(scalar.Interval, equalityOps, comparisonOps)
+	IPAddress = newScalarFilter(scalar.IPAddress, equalityOps, comparisonOps)
+	BitString = newScalarFilter(scalar.BitString, equalityOps, comparisonOps)
+	// String based filters of types having no text operators.
+	TimeTZ     = newFilter("TimeTZFilter", graphql.String, equalityOps, comparisonOps)
+	Money      = newFilter("MoneyFilter", graphql.String, equalityOps, comparisonOps)
+	MACAddress = newFilter("MACAddressFilter", graphql.String, equalityOps, comparisonOps)
+	UUID       = newFilter("UUIDFilter", graphql.String, equalityOps, comparisonOps)
+	// XML is compared to nulls only, Postgres has no equality of xml values.
+	XML = newFilter("XMLFilter", graphql.String, nullOps)
+	// Bytea is compared to nulls only.
+	Bytea = newFilter("ByteaFilter", scalar.Bytea, nullOps)
+	// JSON is compared to nulls only, Postgres has no equality of json values.
+	JSON  = newFilter("JSONFilter", scalar.JSON, nullOps)
+	JSONB = newFilter("JSONBFilter", scalar.JSON, nullOps, jsonbOps)
+)
+
+var (
+	nullOps       = []string{"is_null"}
+	booleanOps    = []string{"eq", "neq", "is_null"}
+	equalityOps   = []string{"eq", "neq", "in", "nin", "is_null"}
+	comparisonOps = []string{"gt", "lt", "gte", "lte"}
+	textOps       = []string{"like", "ilike", "nlike", "similar", "regex", "iregex"}
+	jsonbOps      = []string{"contains", "contained_in", "has_key", "has_keys_any", "has_keys_all"}
+	arrayOps      = []string{"contains", "contained_in", "overlaps", "length"}
+)
+
+type sqlOp struct {
+	prefix string
+	sql    string
+	suffix string
+}
+
+var filterOpToSQL = map[string]sqlOp{
+	"eq":      {sql: "="},
+	"neq":     {sql: "<>"},
+	"gt":      {sql: ">"},
+	"lt":      {sql: "<"},
+	"gte":     {sql: ">="},
+	"lte":     {sql: "<="},
+	"in":      {sql: "=any(", suffix: ")"},
+	"nin":     {sql: "<>all(", suffix: ")"},
+	"like":    {sql: " like "},
+	"ilike":   {sql: " ilike "},
+	"nlike":   {sql: " not like "},
+	"similar": {sql: " similar to "},
+	"regex":   {sql: "~"},
+	"iregex":  {sql: "~*"},
+	// jsonb
+	"contains":     {sql: "@>"},
+	"contained_in": {sql: "<@"},
+	"has_key":      {sql: "?"},
+	"has_keys_any": {sql: "?|"},
+	"has_keys_all": {sql: "?&"},
+	// arrays
+	"overlaps": {sql: "&&"},
+	"length":   {prefix: "cardinality(", sql: ")="},
+}
+
+// builtinFilters are filters of scalars mapped by the generator,
+// custom filters are not named like them because GraphQL type names must be unique.
+var builtinFilters = map[graphql.Input]*graphql.InputObject{
+	graphql.String:   String,
+	graphql.Int:      Int,
+	graphql.Boolean:  Boolean,
+	graphql.Float:    Float,
+	graphql.DateTime: DateTime,
+	scalar.Date:      Date,
+	scalar.Numeric:   Numeric,
+	scalar.BigInt:    BigInt,
+	scalar.Time:      Time,
+	scalar.Interval:  Interval,
+	scalar.IPAddress: IPAddress,
+	scalar.BitString: BitString,
+}
+
+var builtinFilterNames = func() map[string]bool {
+	names := make(map[string]bool)
+	for _, f := range []*graphql.InputObject{
+		String, Int, Boolean, Date, DateTime, Numeric, Float, BigInt, Time, Interval, IPAddress, BitString,
+		TimeTZ, Money, MACAddress, UUID, XML, Bytea, JSON, JSONB,
+	} {
+		names[f.Name()] = true
+	}
+	return names
+}()
+
+type sharedFilterEntry struct {
+	filter *graphql.InputObject
+	ops    [][]string
+}
+
+// sharedFilters keeps filters created on demand,
+// a filter is shared by columns because GraphQL type names must be unique.
+var sharedFilters = struct {
+	sync.Mutex
+	m map[string]sharedFilterEntry
+}{
+	m: make(map[string]sharedFilterEntry),
+}
+
+// sharedFilter returns the filter of the name,
+// it panics when the filter is shared with other operators.
+func sharedFilter(name string, in graphql.Input, ops ...[]string) *graphql.InputObject {
+	sharedFilters.Lock()
+	defer sharedFilters.Unlock()
+
+	if e, ok := sharedFilters.m[name]; ok {
+		if !slices.EqualFunc(e.ops, ops, slices.Equal) {
+			panic("filter " + name + " is shared with other operators")
+		}
+		return e.filter
+	}
+	f := newFilter(name, in, ops...)
+	sharedFilters.m[name] = sharedFilterEntry{filter: f, ops: ops}
+	return f
+}
+
+// Array returns the filter of arrays of the element type.
+func Array(elem graphql.Input) *graphql.InputObject {
+	return sharedFilter(elem.Name()+"ArrayFilter", graphql.NewList(graphql.NewNonNull(elem)), nullOps, arrayOps)
+}
+
+// Scalar returns the filter of the custom scalar,
+// comparison operators are available to comparable scalars only.
+// Built-in scalars have their built-in filters, custom scalars named like them are filtered by "<Name>ScalarFilter".
+// The scalar must be either comparable or not everywhere.
+func Scalar(in graphql.Input, comparable bool) *graphql.InputObject {
+	if f, ok := builtinFilters[in]; ok {
+		return f
+	}
+	name := in.Name() + "Filter"
+	if builtinFilterNames[name] {
+		name = in.Name() + "ScalarFilter"
+	}
+	if comparable {
+		return sharedFilter(name, in, equalityOps, comparisonOps)
+	}
+	return sharedFilter(name, in, equalityOps)
+}
+
+// NewEnum creates the filter of the enum, values are compared for equality only.
+func NewEnum(in *graphql.Enum) *graphql.InputObject {
+	return newScalarFilter(in, equalityOps)
+}
+
+// newScalarFilter creates the filter of a scalar type,
+// only the given operators are available to the scalar.
+func newScalarFilter(in graphql.Input, ops ...[]string) *graphql.InputObject {
+	return newFilter(in.Name()+"Filter", in, ops...)
+}
+
+func newFilter(name string, in graphql.Input, ops ...[]string) *graphql.InputObject {
+	fields := graphql.InputObjectConfigFieldMap{}
+	for _, names := range ops {
+		for _, name := range names {
+			fields[name] = &graphql.InputObjectFieldConfig{
+				Type: opType(name, in),
+			}
+		}
+	}
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:   name,
+		Fields: fields,
+	})
+}
+
+func opType(name string, in graphql.Input) graphql.Input {
+	switch name {
+	case "in", "nin":
+		return graphql.NewList(graphql.NewNonNull(in))
+	case "is_null":
+		return graphql.Boolean
+	case "has_key":
+		return graphql.String
+	case "has_keys_any", "has_keys_all":
+		return graphql.NewList(graphql.NewNonNull(graphql.String))
+	case "length":
+		return graphql.Int
+	default:
+		return in
+	}
+}
@@ -0,0 +1,144 @@
+package filter
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/regeda/turboql/pkg/sqlgen"
+)
+
+var OrderDirection = graphql.NewEnum(graphql.EnumConfig{
+	Name:        "OrderDirection",
+	Description: "The sort direction of a column, nulls are placed as Postgres does unless specified.",
+	Values: graphql.EnumValueConfigMap{
+		"asc": &graphql.EnumValueConfig{
+			Value:       "asc",
+			Description: "in ascending order, nulls last",
+		},
+		"asc_nulls_first": &graphql.EnumValueConfig{
+			Value:       "asc nulls first",
+			Description: "in ascending order, nulls first",
+		},
+		"asc_nulls_last": &graphql.EnumValueConfig{
+			Value:       "asc nulls last",
+			Description: "in ascending order, nulls last",
+		},
+		"desc": &graphql.EnumValueConfig{
+			Value:       "desc",
+			Description: "in descending order, nulls first",
+		},
+		"desc_nulls_first": &graphql.EnumValueConfig{
+			Value:       "desc nulls first",
+			Description: "in descending order, nulls first",
+		},
+		"desc_nulls_last": &graphql.EnumValueConfig{
+			Value:       "desc nulls last",
+			Description: "in descending order, nulls last",
+		},
+	},
+})
+
+var directions = map[string]Order{
+	"asc":              {},
+	"asc nulls first":  {NullsFirst: true},
+	"asc nulls last":   {},
+	"desc":             {Desc: true, NullsFirst: true},
+	"desc nulls first": {Desc: true, NullsFirst: true},
+	"desc nulls last":  {Desc: true},
+}
+
+// Order is a sort key of the query, the column is named by the field.
+type Order struct {
+	Column     string
+	Desc       bool
+	NullsFirst bool
+
+	ident string
+}
+
+// Reverse returns the opposite sort key, it is used to read a page backward.
+func (o Order) Reverse() Order {
+	return Order{
+		Column:     o.Column,
+		Desc:       !o.Desc,
+		NullsFirst: !o.NullsFirst,
+		ident:      o.ident,
+	}
+}
+
+func (o Order) writeSQL(q *bytes.Buffer) {
+	q.WriteString(o.ident)
+	if o.Desc {
+		q.WriteString(" desc")
+		if !o.NullsFirst {
+			q.WriteString(" nulls last")
+		}
+	} else {
+		q.WriteString(" asc")
+		if o.NullsFirst {
+			q.WriteString(" nulls first")
+		}
+	}
+}
+
+// NewOrderByArgumentConfig creates a list of ordering objects,
+// the list order defines the priority of columns.
+func NewOrderByArgumentConfig(name string, columns graphql.InputObjectConfigFieldMap) *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewList(graphql.NewNonNull(graphql.NewInputObject(graphql.InputObjectConfig{
+			Name:   name,
+			Fields: columns,
+		}))),
+	}
+}
+
+// parseOrderBy returns sort keys of the order_by argument, unknown columns are skipped.
+func parseOrderBy(t *sqlgen.Table, p graphql.ResolveParams) []Order {
+	orderBy, ok := p.Args["order_by"].([]any)
+	if !ok {
+		return nil
+	}
+	var order []Order
+	for _, item := range orderBy {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		// the order of keys in a single object is undefined, sort them for stable results
+		names := make([]string, 0, len(m))
+		for name := range m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			dir, ok := m[name].(string)
+			if !ok {
+				continue
+			}
+			o, ok := directions[dir]
+			if !ok {
+				continue
+			}
+			o.ident, ok = t.Column(name)
+			if !ok {
+				continue
+			}
+			o.Column = name
+			order = append(order, o)
+		}
+	}
+	return order
+}
+
+func writeOrderBy(q *bytes.Buffer, order []Order) {
+	for i, o := range order {
+		if i == 0 {
+			q.WriteString(" order by ")
+		} else {
+			q.WriteByte(',')
+		}
+		o.writeSQL(q)
+	}
+}
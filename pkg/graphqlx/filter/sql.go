@@ -2,46 +2,294 @@ package filter
 
 import (
 	"bytes"
+	"sort"
 	"strconv"
 
 	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+
+	"github.com/regeda/turboql/pkg/sqlgen"
 )
 
-var filterOpToSQL = map[string]string{
-	"eq":  "=",
-	"gt":  ">",
-	"lt":  "<",
-	"gte": ">=",
-	"lte": "<=",
+// SQL appends the filter, the ordering and the limit to the base query,
+// arguments name columns of the table only.
+func SQL(t *sqlgen.Table, base string, args []any, p graphql.ResolveParams) (string, []any, error) {
+	q := bytes.NewBufferString(base)
+	args, err := writeFilter(q, t, args, p)
+	if err != nil {
+		return "", nil, err
+	}
+	writeOrderBy(q, parseOrderBy(t, p))
+	if limit, ok := p.Args["limit"].(int); ok {
+		q.WriteString(" limit ")
+		q.WriteString(strconv.Itoa(limit))
+	}
+	if offset, ok := p.Args["offset"].(int); ok {
+		q.WriteString(" offset ")
+		q.WriteString(strconv.Itoa(offset))
+	}
+	return q.String(), args, nil
 }
 
-func SQL(base string, args []any, p graphql.ResolveParams) (string, []any) {
-	q := bytes.NewBufferString(base)
-	seq := len(args) + 1
-	if filter, ok := p.Args["filter"].(map[string]any); ok {
-		for name, arg := range filter {
-			if m, ok := arg.(map[string]any); ok {
-				for filterOp, v := range m {
-					if sqlOp, ok := filterOpToSQL[filterOp]; ok {
-						// sql
-						q.WriteString(" and ")
-						q.WriteString(name)
-						q.WriteString(sqlOp)
-						q.WriteByte('$')
-						q.WriteString(strconv.Itoa(seq))
-						seq++
-						// arg
-						args = append(args, v)
-						// apply only the first matched operator
-						break
+// rowNumber is the column of the position of a row in the partition.
+const rowNumber = `"_turboql_row"`
+
+// PartitionSQL selects columns from the table by the where clause like SQL does,
+// but the limit and the offset apply to every partition of rows, e.g. to children of every parent.
+func PartitionSQL(t *sqlgen.Table, columns, from, partition string, args []any, p graphql.ResolveParams) (string, []any, error) {
+	limit, hasLimit := p.Args["limit"].(int)
+	offset, hasOffset := p.Args["offset"].(int)
+	if !hasLimit && !hasOffset {
+		return SQL(t, "select "+columns+" from "+from, args, p)
+	}
+	order := parseOrderBy(t, p)
+
+	q := bytes.NewBufferString("select ")
+	q.WriteString(columns)
+	q.WriteString(" from (select ")
+	q.WriteString(columns)
+	q.WriteString(",row_number() over (partition by ")
+	q.WriteString(partition)
+	writeOrderBy(q, order)
+	q.WriteString(") as ")
+	q.WriteString(rowNumber)
+	q.WriteString(" from ")
+	q.WriteString(from)
+	args, err := writeFilter(q, t, args, p)
+	if err != nil {
+		return "", nil, err
+	}
+	q.WriteString(") as t where 1=1")
+	if hasOffset {
+		q.WriteString(" and ")
+		q.WriteString(rowNumber)
+		q.WriteString(">")
+		q.WriteString(strconv.Itoa(offset))
+	}
+	if hasLimit {
+		q.WriteString(" and ")
+		q.WriteString(rowNumber)
+		q.WriteString("<=")
+		q.WriteString(strconv.Itoa(offset + limit))
+	}
+	q.WriteString(" order by ")
+	q.WriteString(rowNumber)
+	return q.String(), args, nil
+}
+
+func writeFilter(q *bytes.Buffer, t *sqlgen.Table, args []any, p graphql.ResolveParams) ([]any, error) {
+	filter, ok := p.Args["filter"].(map[string]any)
+	if !ok || len(filter) == 0 {
+		return args, nil
+	}
+	b := &builder{q: q, t: t, args: args}
+	q.WriteString(" and ")
+	if err := b.writeAnd(filter); err != nil {
+		return nil, errors.WithMessage(err, "filter")
+	}
+	return b.args, nil
+}
+
+// builder renders the filter tree, every column operator and combinator
+// of a single filter object are joined by "and".
+type builder struct {
+	q    *bytes.Buffer
+	t    *sqlgen.Table
+	args []any
+	// depth is the level of nested relation filters, rows of the level are aliased by it
+	depth int
+}
+
+// qualifier returns the name of rows of the table in the query.
+func (b *builder) qualifier() string {
+	if b.depth == 0 {
+		return b.t.Ident()
+	}
+	return "r" + strconv.Itoa(b.depth)
+}
+
+// writeExists matches rows having any related row matched by the filter.
+func (b *builder) writeExists(rel sqlgen.Relation, filter map[string]any) error {
+	child := &builder{q: b.q, t: rel.Table, args: b.args, depth: b.depth + 1}
+	alias := child.qualifier()
+	b.q.WriteString("exists (select 1 from ")
+	b.q.WriteString(rel.Table.Ident())
+	b.q.WriteString(" as ")
+	b.q.WriteString(alias)
+	b.q.WriteString(" where ")
+	for i := range rel.Columns {
+		b.q.WriteString(alias)
+		b.q.WriteByte('.')
+		b.q.WriteString(rel.Columns[i])
+		b.q.WriteByte('=')
+		b.q.WriteString(b.qualifier())
+		b.q.WriteByte('.')
+		b.q.WriteString(rel.Parent[i])
+		b.q.WriteString(" and ")
+	}
+	if err := child.writeAnd(filter); err != nil {
+		return err
+	}
+	b.q.WriteByte(')')
+	b.args = child.args
+	return nil
+}
+
+func (b *builder) writeAnd(filter map[string]any) error {
+	n := 0
+	sep := func() {
+		if n > 0 {
+			b.q.WriteString(" and ")
+		}
+		n++
+	}
+	for _, name := range sortedKeys(filter) {
+		switch name {
+		case "_and":
+			filters, err := filterList(filter[name])
+			if err != nil {
+				return errors.WithMessage(err, name)
+			}
+			for _, f := range filters {
+				sep()
+				b.q.WriteByte('(')
+				if err := b.writeAnd(f); err != nil {
+					return err
+				}
+				b.q.WriteByte(')')
+			}
+		case "_or":
+			if filter[name] == nil {
+				continue
+			}
+			filters, err := filterList(filter[name])
+			if err != nil {
+				return errors.WithMessage(err, name)
+			}
+			sep()
+			if err := b.writeOr(filters); err != nil {
+				return err
+			}
+		case "_not":
+			if f, ok := filter[name].(map[string]any); ok {
+				sep()
+				b.q.WriteString("not (")
+				if err := b.writeAnd(f); err != nil {
+					return err
+				}
+				b.q.WriteByte(')')
+			}
+		default:
+			ops, ok := filter[name].(map[string]any)
+			if !ok {
+				continue
+			}
+			if rel, ok := b.t.Relation(name); ok {
+				sep()
+				if err := b.writeExists(rel, ops); err != nil {
+					return errors.WithMessage(err, name)
+				}
+				continue
+			}
+			col, ok := b.t.Column(name)
+			if !ok {
+				// unknown columns never match rather than being ignored,
+				// otherwise the filter of update and delete would be wider than requested
+				sep()
+				b.q.WriteString("false")
+				continue
+			}
+			for _, op := range sortedKeys(ops) {
+				v := ops[op]
+				if v == nil {
+					continue
+				}
+				switch op {
+				case "is_null":
+					isNull, ok := v.(bool)
+					if !ok {
+						continue
+					}
+					sep()
+					b.q.WriteString(col)
+					if isNull {
+						b.q.WriteString(" is null")
+					} else {
+						b.q.WriteString(" is not null")
+					}
+				default:
+					sqlOp, ok := filterOpToSQL[op]
+					if !ok {
+						continue
 					}
+					sep()
+					b.q.WriteString(sqlOp.prefix)
+					b.q.WriteString(col)
+					b.q.WriteString(sqlOp.sql)
+					b.param(v)
+					b.q.WriteString(sqlOp.suffix)
 				}
 			}
 		}
 	}
-	if limit, ok := p.Args["limit"].(int); ok {
-		q.WriteString(" limit ")
-		q.WriteString(strconv.Itoa(limit))
+	if n == 0 {
+		b.q.WriteString("true")
+	}
+	return nil
+}
+
+func (b *builder) writeOr(filters []map[string]any) error {
+	if len(filters) == 0 {
+		b.q.WriteString("false")
+		return nil
+	}
+	b.q.WriteByte('(')
+	for i, f := range filters {
+		if i > 0 {
+			b.q.WriteString(" or ")
+		}
+		b.q.WriteByte('(')
+		if err := b.writeAnd(f); err != nil {
+			return err
+		}
+		b.q.WriteByte(')')
+	}
+	b.q.WriteByte(')')
+	return nil
+}
+
+func (b *builder) param(v any) {
+	b.args = append(b.args, v)
+	b.q.WriteByte('$')
+	b.q.WriteString(strconv.Itoa(len(b.args)))
+}
+
+// filterList returns filters of the combinator, null means no filters.
+func filterList(v any) ([]map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	list, ok := v.([]any)
+	if !ok {
+		return nil, errors.Errorf("unexpected list of filters %T", v)
+	}
+	filters := make([]map[string]any, len(list))
+	for i, item := range list {
+		f, ok := item.(map[string]any)
+		if !ok {
+			return nil, errors.Errorf("unexpected filter %T", item)
+		}
+		filters[i] = f
+	}
+	return filters, nil
+}
+
+// sortedKeys makes the query text stable, so prepared statements are reused.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
-	return q.String(), args
+	sort.Strings(keys)
+	return keys
 }
@@ -1,12 +1,16 @@
 package filter_test
 
 import (
+	"encoding/json"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/stretchr/testify/require"
 
 	"github.com/regeda/turboql/pkg/graphqlx/filter"
+	"github.com/regeda/turboql/pkg/sqlgen"
 )
 
 func Test_Filter_SQL(t *testing.T) {
@@ -32,7 +36,265 @@ func Test_Filter_SQL(t *testing.T) {
 					},
 				},
 			},
-			expectedSQL:  "select and foo=$1",
+			expectedSQL:  "select and \"foo\"=$1",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "every operator of a column",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"gt": 1,
+						"lt": 10,
+					},
+				},
+			},
+			expectedSQL:  "select and \"foo\">$1 and \"foo\"<$2",
+			expectedArgs: []any{1, 10},
+		},
+		{
+			name: "list operators",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"in":  []any{1, 2},
+						"nin": []any{3},
+					},
+				},
+			},
+			expectedSQL:  "select and \"foo\"=any($1) and \"foo\"<>all($2)",
+			expectedArgs: []any{[]any{1, 2}, []any{3}},
+		},
+		{
+			name: "text operators",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"ilike":   "%a%",
+						"nlike":   "b%",
+						"regex":   "^c",
+						"similar": "d|e",
+					},
+				},
+			},
+			expectedSQL:  "select and \"foo\" ilike $1 and \"foo\" not like $2 and \"foo\"~$3 and \"foo\" similar to $4",
+			expectedArgs: []any{"%a%", "b%", "^c", "d|e"},
+		},
+		{
+			name: "boolean equality",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"eq": true,
+					},
+				},
+			},
+			expectedSQL:  "select and \"foo\"=$1",
+			expectedArgs: []any{true},
+		},
+		{
+			name: "date range",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"Order_Date": map[string]any{
+						"gte": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						"lt":  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			expectedSQL:  "select and \"Order Date\">=$1 and \"Order Date\"<$2",
+			expectedArgs: []any{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "uuid operators",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"id": map[string]any{
+						"gte": "00000000-0000-0000-0000-000000000001",
+						"nin": []any{"00000000-0000-0000-0000-000000000002"},
+					},
+				},
+			},
+			expectedSQL:  "select and \"id\">=$1 and \"id\"<>all($2)",
+			expectedArgs: []any{"00000000-0000-0000-0000-000000000001", []any{"00000000-0000-0000-0000-000000000002"}},
+		},
+		{
+			name: "jsonb operators",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"contained_in": json.RawMessage(`{"a":1,"b":2}`),
+						"contains":     json.RawMessage(`{"a":1}`),
+						"has_key":      "a",
+						"has_keys_all": []any{"a", "b"},
+						"has_keys_any": []any{"c"},
+					},
+				},
+			},
+			expectedSQL: "select and \"foo\"<@$1 and \"foo\"@>$2 and \"foo\"?$3 and \"foo\"?&$4 and \"foo\"?|$5",
+			expectedArgs: []any{
+				json.RawMessage(`{"a":1,"b":2}`),
+				json.RawMessage(`{"a":1}`),
+				"a",
+				[]any{"a", "b"},
+				[]any{"c"},
+			},
+		},
+		{
+			name: "array operators",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"contains": []any{1},
+						"length":   2,
+						"overlaps": []any{1, 3},
+					},
+				},
+			},
+			expectedSQL:  "select and \"foo\"@>$1 and cardinality(\"foo\")=$2 and \"foo\"&&$3",
+			expectedArgs: []any{[]any{1}, 2, []any{1, 3}},
+		},
+		{
+			name: "null checks",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"is_null": true,
+					},
+					"bar": map[string]any{
+						"is_null": false,
+						"neq":     1,
+					},
+				},
+			},
+			expectedSQL:  "select and \"bar\" is not null and \"bar\"<>$1 and \"foo\" is null",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "multiple columns",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"eq": 1,
+					},
+					"bar": map[string]any{
+						"eq": "x",
+					},
+				},
+			},
+			expectedSQL:  "select and \"bar\"=$1 and \"foo\"=$2",
+			expectedArgs: []any{"x", 1},
+		},
+		{
+			name: "or combinator",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"_or": []any{
+						map[string]any{
+							"price": map[string]any{
+								"gt": 10,
+							},
+						},
+						map[string]any{
+							"title": map[string]any{
+								"eq": "x",
+							},
+						},
+					},
+				},
+			},
+			expectedSQL:  "select and ((\"price\">$1) or (\"title\"=$2))",
+			expectedArgs: []any{10, "x"},
+		},
+		{
+			name: "nested combinators",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"eq": 1,
+					},
+					"_not": map[string]any{
+						"_or": []any{
+							map[string]any{
+								"bar": map[string]any{
+									"eq": 2,
+								},
+							},
+							map[string]any{
+								"_and": []any{
+									map[string]any{
+										"bar": map[string]any{
+											"gte": 3,
+										},
+									},
+									map[string]any{
+										"baz": map[string]any{
+											"lte": 4,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSQL:  "select and not (((\"bar\"=$1) or ((\"bar\">=$2) and (\"baz\"<=$3)))) and \"foo\"=$4",
+			expectedArgs: []any{2, 3, 4, 1},
+		},
+		{
+			name: "empty or combinator",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"_or": []any{},
+				},
+			},
+			expectedSQL: "select and false",
+		},
+		{
+			name: "unknown column",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"eq": 1,
+					},
+					"foo;drop table t": map[string]any{
+						"eq": 1,
+					},
+				},
+				"order_by": []any{
+					map[string]any{
+						"foo;drop table t": "asc",
+					},
+				},
+			},
+			expectedSQL:  "select and \"foo\"=$1 and false",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "field of the quoted column",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"Order_Date": map[string]any{
+						"eq": 1,
+					},
+				},
+			},
+			expectedSQL:  "select and \"Order Date\"=$1",
 			expectedArgs: []any{1},
 		},
 		{
@@ -54,19 +316,318 @@ func Test_Filter_SQL(t *testing.T) {
 				},
 				"limit": 100,
 			},
-			expectedSQL:  "select and foo=$1 limit 100",
+			expectedSQL:  "select and \"foo\"=$1 limit 100",
 			expectedArgs: []any{1},
 		},
+		{
+			name: "order by multiple columns",
+			base: "select",
+			args: map[string]any{
+				"order_by": []any{
+					map[string]any{
+						"foo": "desc nulls last",
+					},
+					map[string]any{
+						"qux": "asc",
+						"bar": "asc nulls first",
+					},
+				},
+			},
+			expectedSQL: "select order by \"foo\" desc nulls last,\"bar\" asc nulls first,\"qux\" asc",
+		},
+		{
+			name: "filter, order by and limit",
+			base: "select",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"eq": 1,
+					},
+				},
+				"order_by": []any{
+					map[string]any{
+						"foo": "desc",
+					},
+				},
+				"limit": 100,
+			},
+			expectedSQL:  "select and \"foo\"=$1 order by \"foo\" desc limit 100",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "limit and offset",
+			base: "select",
+			args: map[string]any{
+				"limit":  10,
+				"offset": 20,
+			},
+			expectedSQL: "select limit 10 offset 20",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sql, args, err := filter.SQL(table, c.base, nil, graphql.ResolveParams{
+				Args: c.args,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, c.expectedSQL, sql)
+			require.Equal(t, c.expectedArgs, args)
+		})
+	}
+}
+
+func Test_Filter_PartitionSQL(t *testing.T) {
+	cases := []struct {
+		name         string
+		args         map[string]any
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name: "no limit",
+			args: map[string]any{
+				"order_by": []any{
+					map[string]any{
+						"foo": "desc",
+					},
+				},
+			},
+			expectedSQL:  "select \"id\",\"foo\" from \"t\" where 1=1 and \"bar\" = any($1) order by \"foo\" desc",
+			expectedArgs: []any{[]int{1, 2}},
+		},
+		{
+			name: "limit",
+			args: map[string]any{
+				"limit": 10,
+			},
+			expectedSQL:  "select \"id\",\"foo\" from (select \"id\",\"foo\",row_number() over (partition by \"bar\") as \"_turboql_row\" from \"t\" where 1=1 and \"bar\" = any($1)) as t where 1=1 and \"_turboql_row\"<=10 order by \"_turboql_row\"",
+			expectedArgs: []any{[]int{1, 2}},
+		},
+		{
+			name: "filter, order by, limit and offset",
+			args: map[string]any{
+				"filter": map[string]any{
+					"foo": map[string]any{
+						"eq": 1,
+					},
+				},
+				"order_by": []any{
+					map[string]any{
+						"foo": "desc",
+					},
+				},
+				"limit":  10,
+				"offset": 20,
+			},
+			expectedSQL:  "select \"id\",\"foo\" from (select \"id\",\"foo\",row_number() over (partition by \"bar\" order by \"foo\" desc) as \"_turboql_row\" from \"t\" where 1=1 and \"bar\" = any($1) and \"foo\"=$2) as t where 1=1 and \"_turboql_row\">20 and \"_turboql_row\"<=30 order by \"_turboql_row\"",
+			expectedArgs: []any{[]int{1, 2}, 1},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sql, args, err := filter.PartitionSQL(table, `"id","foo"`, `"t" where 1=1 and "bar" = any($1)`, `"bar"`, []any{[]int{1, 2}}, graphql.ResolveParams{
+				Args: c.args,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, c.expectedSQL, sql)
+			require.Equal(t, c.expectedArgs, args)
+		})
+	}
+}
+
+var table = sqlgen.NewTable("public", "t", map[string]string{
+	"id":    "id",
+	"foo":   "foo",
+	"bar":   "bar",
+	"baz":   "baz",
+	"qux":   "qux",
+	"price": "price",
+	"title": "title",
+	// a column name is not a valid GraphQL name
+	"Order_Date": "Order Date",
+})
+
+func Test_Filter_Array(t *testing.T) {
+	f := filter.Array(graphql.Int)
+
+	require.Equal(t, "IntArrayFilter", f.Name())
+	require.Same(t, f, filter.Array(graphql.Int))
+	require.Equal(t, graphql.NewList(graphql.NewNonNull(graphql.Int)).String(), f.Fields()["overlaps"].Type.String())
+	require.Equal(t, graphql.Int, f.Fields()["length"].Type)
+}
+
+func Test_Filter_Operators(t *testing.T) {
+	names := func(f *graphql.InputObject) []string {
+		var ops []string
+		for name := range f.Fields() {
+			ops = append(ops, name)
+		}
+		sort.Strings(ops)
+		return ops
+	}
+
+	require.Equal(t, []string{"eq", "is_null", "neq"}, names(filter.Boolean))
+	require.Equal(t, []string{"eq", "gt", "gte", "in", "is_null", "lt", "lte", "neq", "nin"}, names(filter.Date))
+	require.Equal(t, []string{"is_null"}, names(filter.Bytea))
+}
+
+func Test_Filter_UUID(t *testing.T) {
+	fields := filter.UUID.Fields()
+
+	require.Equal(t, "UUIDFilter", filter.UUID.Name())
+	require.Contains(t, fields, "eq")
+	require.Contains(t, fields, "gt")
+	require.NotContains(t, fields, "like")
+	require.NotContains(t, fields, "regex")
+}
+
+func Test_Filter_Relation(t *testing.T) {
+	employee := sqlgen.NewTable("public", "employee", map[string]string{
+		"id":         "id",
+		"name":       "name",
+		"manager_id": "manager_id",
+		"dept_id":    "dept_id",
+	})
+	dept := sqlgen.NewTable("public", "dept", map[string]string{
+		"id":    "id",
+		"title": "title",
+	})
+	employee.AddRelation("manager", employee, []string{"id"}, []string{"manager_id"})
+	employee.AddRelation("dept", dept, []string{"id"}, []string{"dept_id"})
+	dept.AddRelation("employees", employee, []string{"dept_id"}, []string{"id"})
+
+	cases := []struct {
+		name         string
+		table        *sqlgen.Table
+		args         map[string]any
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name:  "many-to-one",
+			table: employee,
+			args: map[string]any{
+				"filter": map[string]any{
+					"dept": map[string]any{
+						"title": map[string]any{
+							"eq": "R&D",
+						},
+					},
+				},
+			},
+			expectedSQL:  `select and exists (select 1 from "public"."dept" as r1 where r1."id"="public"."employee"."dept_id" and "title"=$1)`,
+			expectedArgs: []any{"R&D"},
+		},
+		{
+			name:  "one-to-many of nested relations",
+			table: dept,
+			args: map[string]any{
+				"filter": map[string]any{
+					"employees": map[string]any{
+						"manager": map[string]any{
+							"name": map[string]any{
+								"eq": "Bob",
+							},
+						},
+					},
+					"title": map[string]any{
+						"eq": "R&D",
+					},
+				},
+			},
+			expectedSQL:  `select and exists (select 1 from "public"."employee" as r1 where r1."dept_id"="public"."dept"."id" and exists (select 1 from "public"."employee" as r2 where r2."id"=r1."manager_id" and "name"=$1)) and "title"=$2`,
+			expectedArgs: []any{"Bob", "R&D"},
+		},
+		{
+			name:  "empty filter of the relation",
+			table: employee,
+			args: map[string]any{
+				"filter": map[string]any{
+					"_not": map[string]any{
+						"manager": map[string]any{},
+					},
+				},
+			},
+			expectedSQL: `select and not (exists (select 1 from "public"."employee" as r1 where r1."id"="public"."employee"."manager_id" and true))`,
+		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			sql, args := filter.SQL(c.base, nil, graphql.ResolveParams{
+			sql, args, err := filter.SQL(c.table, "select", nil, graphql.ResolveParams{
 				Args: c.args,
 			})
 
+			require.NoError(t, err)
 			require.Equal(t, c.expectedSQL, sql)
 			require.Equal(t, c.expectedArgs, args)
 		})
 	}
 }
+
+func Test_Filter_SQL_InvalidList(t *testing.T) {
+	cases := []struct {
+		name     string
+		filter   map[string]any
+		expected string
+	}{
+		{
+			name:     "object of _or",
+			filter:   map[string]any{"_or": map[string]any{}},
+			expected: "filter: _or: unexpected list of filters map[string]interface {}",
+		},
+		{
+			name:     "item of _and",
+			filter:   map[string]any{"_and": []any{"foo"}},
+			expected: "filter: _and: unexpected filter string",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, _, err := filter.SQL(table, "select", nil, graphql.ResolveParams{
+				Args: map[string]any{"filter": c.filter},
+			})
+
+			require.EqualError(t, err, c.expected)
+		})
+	}
+}
+
+func Test_Filter_Scalar(t *testing.T) {
+	uuid := graphql.NewScalar(graphql.ScalarConfig{
+		Name:      "UUID",
+		Serialize: func(v any) any { return v },
+	})
+
+	require.Same(t, filter.String, filter.Scalar(graphql.String, true))
+	require.Same(t, filter.Int, filter.Scalar(graphql.Int, false))
+
+	f := filter.Scalar(uuid, true)
+	require.Equal(t, "UUIDScalarFilter", f.Name())
+	require.Same(t, f, filter.Scalar(uuid, true))
+	require.Contains(t, f.Fields(), "gt")
+
+	require.Panics(t, func() { filter.Scalar(uuid, false) })
+
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"custom": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{"filter": &graphql.ArgumentConfig{Type: f}},
+				},
+				"builtin": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{"filter": &graphql.ArgumentConfig{Type: filter.UUID}},
+				},
+			},
+		}),
+	})
+	require.NoError(t, err)
+}
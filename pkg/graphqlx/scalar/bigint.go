@@ -0,0 +1,92 @@
+package scalar
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// maxSafeInteger is the greatest integer represented exactly by float64.
+const maxSafeInteger = 1 << 53
+
+// serializeBigInt emits the decimal string,
+// JSON clients lose the precision of numbers beyond 2^53.
+func serializeBigInt(value any) any {
+	switch v := value.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case *int64:
+		if v != nil {
+			return serializeBigInt(*v)
+		}
+	case int:
+		return strconv.Itoa(v)
+	case *int:
+		if v != nil {
+			return serializeBigInt(*v)
+		}
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case *uint32:
+		if v != nil {
+			return serializeBigInt(*v)
+		}
+	}
+	return nil
+}
+
+// parseBigInt accepts decimal strings and integral numbers of variables.
+func parseBigInt(value any) any {
+	switch v := value.(type) {
+	case string:
+		return scanBigInt(v)
+	case json.Number:
+		return scanBigInt(v.String())
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) <= maxSafeInteger {
+			return int64(v)
+		}
+	case *string:
+		if v != nil {
+			return parseBigInt(*v)
+		}
+	}
+	return nil
+}
+
+func parseLiteralBigInt(valueAST ast.Value) any {
+	switch v := valueAST.(type) {
+	case *ast.IntValue:
+		return scanBigInt(v.Value)
+	case *ast.StringValue:
+		return scanBigInt(v.Value)
+	}
+	return nil
+}
+
+func scanBigInt(s string) any {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return n
+}
+
+var BigInt = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "BigInt",
+	Description:  "The `BigInt` type represents a 64-bit integer, it is serialized as a string to keep the precision.",
+	Serialize:    serializeBigInt,
+	ParseValue:   parseBigInt,
+	ParseLiteral: parseLiteralBigInt,
+})
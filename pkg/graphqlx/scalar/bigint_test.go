@@ -0,0 +1,36 @@
+package scalar_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_BigInt_Serialize(t *testing.T) {
+	v := int64(math.MaxInt64)
+
+	require.Equal(t, "9223372036854775807", scalar.BigInt.Serialize(v))
+	require.Equal(t, "9223372036854775807", scalar.BigInt.Serialize(&v))
+	require.Equal(t, "4294967295", scalar.BigInt.Serialize(uint32(math.MaxUint32)))
+	require.Nil(t, scalar.BigInt.Serialize((*int64)(nil)))
+}
+
+func Test_BigInt_ParseValue(t *testing.T) {
+	require.Equal(t, int64(math.MaxInt64), scalar.BigInt.ParseValue("9223372036854775807"))
+	require.Equal(t, int64(42), scalar.BigInt.ParseValue(json.Number("42")))
+	require.Equal(t, int64(-7), scalar.BigInt.ParseValue(float64(-7)))
+	require.Nil(t, scalar.BigInt.ParseValue(1.5))
+	require.Nil(t, scalar.BigInt.ParseValue(float64(1<<60)))
+	require.Nil(t, scalar.BigInt.ParseValue("9223372036854775808"))
+}
+
+func Test_BigInt_ParseLiteral(t *testing.T) {
+	require.Equal(t, int64(9007199254740993), scalar.BigInt.ParseLiteral(&ast.IntValue{Value: "9007199254740993"}))
+	require.Equal(t, int64(1), scalar.BigInt.ParseLiteral(&ast.StringValue{Value: "1"}))
+	require.Nil(t, scalar.BigInt.ParseLiteral(&ast.FloatValue{Value: "1.0"}))
+}
@@ -0,0 +1,71 @@
+package scalar
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// serializeBitString emits bits as the string of 0 and 1.
+func serializeBitString(value any) any {
+	switch v := value.(type) {
+	case pgtype.Bits:
+		if !v.Valid {
+			return nil
+		}
+		s, err := v.Value()
+		if err != nil {
+			return nil
+		}
+		return s
+	case *pgtype.Bits:
+		if v != nil {
+			return serializeBitString(*v)
+		}
+	}
+	return nil
+}
+
+func parseBitString(value any) any {
+	switch v := value.(type) {
+	case string:
+		return scanBitString(v)
+	case *string:
+		if v != nil {
+			return scanBitString(*v)
+		}
+	case pgtype.Bits:
+		if v.Valid {
+			return v
+		}
+	}
+	return nil
+}
+
+func parseLiteralBitString(valueAST ast.Value) any {
+	if v, ok := valueAST.(*ast.StringValue); ok {
+		return scanBitString(v.Value)
+	}
+	return nil
+}
+
+func scanBitString(s string) any {
+	if strings.Trim(s, "01") != "" {
+		return nil
+	}
+	var b pgtype.Bits
+	if err := b.Scan(s); err != nil {
+		return nil
+	}
+	return b
+}
+
+var BitString = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "BitString",
+	Description:  "The `BitString` type represents bit and bit varying values as strings of 0 and 1.",
+	Serialize:    serializeBitString,
+	ParseValue:   parseBitString,
+	ParseLiteral: parseLiteralBitString,
+})
@@ -0,0 +1,22 @@
+package scalar_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_BitString(t *testing.T) {
+	v := scalar.BitString.ParseValue("101100001")
+	require.IsType(t, pgtype.Bits{}, v)
+	require.Equal(t, int32(9), v.(pgtype.Bits).Len)
+	require.Equal(t, "101100001", scalar.BitString.Serialize(v))
+
+	require.Nil(t, scalar.BitString.ParseValue("10a"))
+	require.Nil(t, scalar.BitString.Serialize(pgtype.Bits{}))
+	require.Equal(t, "", scalar.BitString.Serialize(scalar.BitString.ParseLiteral(&ast.StringValue{Value: ""})))
+}
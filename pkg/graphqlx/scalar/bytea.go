@@ -0,0 +1,66 @@
+package scalar
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// serializeBytea emits binary values in the hex format of Postgres, e.g. "\x010203".
+func serializeBytea(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		if v == nil {
+			return nil
+		}
+		return `\x` + hex.EncodeToString(v)
+	case *[]byte:
+		if v != nil {
+			return serializeBytea(*v)
+		}
+	}
+	return nil
+}
+
+func parseBytea(value any) any {
+	switch v := value.(type) {
+	case string:
+		return scanBytea(v)
+	case *string:
+		if v != nil {
+			return scanBytea(*v)
+		}
+	case []byte:
+		return v
+	}
+	return nil
+}
+
+func parseLiteralBytea(valueAST ast.Value) any {
+	if v, ok := valueAST.(*ast.StringValue); ok {
+		return scanBytea(v.Value)
+	}
+	return nil
+}
+
+func scanBytea(s string) any {
+	h, ok := strings.CutPrefix(s, `\x`)
+	if !ok {
+		return nil
+	}
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+var Bytea = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "Bytea",
+	Description:  "The `Bytea` type represents binary values as hex strings prefixed by `\\x`.",
+	Serialize:    serializeBytea,
+	ParseValue:   parseBytea,
+	ParseLiteral: parseLiteralBytea,
+})
@@ -0,0 +1,26 @@
+package scalar_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_Bytea(t *testing.T) {
+	v := scalar.Bytea.ParseValue(`\x010203`)
+	require.Equal(t, []byte{1, 2, 3}, v)
+	require.Equal(t, `\x010203`, scalar.Bytea.Serialize(v))
+	require.Equal(t, `\x`, scalar.Bytea.Serialize([]byte{}))
+
+	b := []byte{0xff}
+	require.Equal(t, `\xff`, scalar.Bytea.Serialize(&b))
+
+	require.Nil(t, scalar.Bytea.Serialize([]byte(nil)))
+	require.Nil(t, scalar.Bytea.Serialize((*[]byte)(nil)))
+	require.Nil(t, scalar.Bytea.ParseValue("010203"))
+	require.Nil(t, scalar.Bytea.ParseValue(`\x0g`))
+	require.Equal(t, []byte{0xab}, scalar.Bytea.ParseLiteral(&ast.StringValue{Value: `\xab`}))
+}
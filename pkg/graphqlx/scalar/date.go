@@ -17,18 +17,40 @@ func (v date) MarshalJSON() ([]byte, error) {
 }
 
 func serializeDate(value any) any {
-	if v, ok := value.(time.Time); ok {
+	switch v := value.(type) {
+	case time.Time:
 		return date{Time: v}
+	case *time.Time:
+		if v != nil {
+			return date{Time: *v}
+		}
 	}
 	return nil
 }
 
+// parseDate accepts YYYY-MM-DD strings and times of variables,
+// nil is returned for bad input and it is reported by GraphQL as an invalid value.
 func parseDate(value any) any {
-	panic("not yet implemented")
+	switch v := value.(type) {
+	case string:
+		if t, err := time.Parse(time.DateOnly, v); err == nil {
+			return t
+		}
+	case time.Time:
+		return time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, time.UTC)
+	case *time.Time:
+		if v != nil {
+			return parseDate(*v)
+		}
+	}
+	return nil
 }
 
 func parseLiteralDate(valueAST ast.Value) any {
-	panic("not yet implemented")
+	if v, ok := valueAST.(*ast.StringValue); ok {
+		return parseDate(v.Value)
+	}
+	return nil
 }
 
 var Date = graphql.NewScalar(graphql.ScalarConfig{
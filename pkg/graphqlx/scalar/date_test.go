@@ -0,0 +1,42 @@
+package scalar_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_Date_Serialize(t *testing.T) {
+	d := time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)
+
+	b, err := json.Marshal(scalar.Date.Serialize(d))
+	require.NoError(t, err)
+	require.JSONEq(t, `"2024-02-29"`, string(b))
+
+	b, err = json.Marshal(scalar.Date.Serialize(&d))
+	require.NoError(t, err)
+	require.JSONEq(t, `"2024-02-29"`, string(b))
+
+	require.Nil(t, scalar.Date.Serialize((*time.Time)(nil)))
+}
+
+func Test_Date_ParseValue(t *testing.T) {
+	expected := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, expected, scalar.Date.ParseValue("2024-02-29"))
+	require.Equal(t, expected, scalar.Date.ParseValue(time.Date(2024, 2, 29, 23, 59, 0, 0, time.FixedZone("", 3600))))
+	require.Nil(t, scalar.Date.ParseValue("2023-02-29"))
+	require.Nil(t, scalar.Date.ParseValue("2024-02-29T00:00:00Z"))
+	require.Nil(t, scalar.Date.ParseValue(20240229))
+}
+
+func Test_Date_ParseLiteral(t *testing.T) {
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), scalar.Date.ParseLiteral(&ast.StringValue{Value: "2024-01-02"}))
+	require.Nil(t, scalar.Date.ParseLiteral(&ast.StringValue{Value: "01/02/2024"}))
+	require.Nil(t, scalar.Date.ParseLiteral(&ast.IntValue{Value: "20240102"}))
+}
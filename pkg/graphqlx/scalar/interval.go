@@ -0,0 +1,138 @@
+package scalar
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// serializeInterval emits the ISO 8601 duration as Postgres does with IntervalStyle iso_8601,
+// components keep their own signs because months, days and time are not convertible.
+func serializeInterval(value any) any {
+	switch v := value.(type) {
+	case pgtype.Interval:
+		if !v.Valid {
+			return nil
+		}
+		return formatInterval(v)
+	case *pgtype.Interval:
+		if v != nil {
+			return serializeInterval(*v)
+		}
+	}
+	return nil
+}
+
+func formatInterval(v pgtype.Interval) string {
+	if v.Months == 0 && v.Days == 0 && v.Microseconds == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	b.WriteByte('P')
+	writeComponent(&b, int64(v.Months/12), 'Y')
+	writeComponent(&b, int64(v.Months%12), 'M')
+	writeComponent(&b, int64(v.Days), 'D')
+	if v.Microseconds != 0 {
+		b.WriteByte('T')
+		us := v.Microseconds
+		h := us / int64(time.Hour/time.Microsecond)
+		us -= h * int64(time.Hour/time.Microsecond)
+		m := us / int64(time.Minute/time.Microsecond)
+		us -= m * int64(time.Minute/time.Microsecond)
+		writeComponent(&b, h, 'H')
+		writeComponent(&b, m, 'M')
+		if us != 0 {
+			s := us / int64(time.Second/time.Microsecond)
+			frac := us - s*int64(time.Second/time.Microsecond)
+			if us < 0 {
+				b.WriteByte('-')
+				s, frac = -s, -frac
+			}
+			b.WriteString(strconv.FormatInt(s, 10))
+			b.WriteString(formatFraction(frac))
+			b.WriteByte('S')
+		}
+	}
+	return b.String()
+}
+
+func writeComponent(b *strings.Builder, n int64, unit byte) {
+	if n != 0 {
+		b.WriteString(strconv.FormatInt(n, 10))
+		b.WriteByte(unit)
+	}
+}
+
+var intervalRe = regexp.MustCompile(`^P(?:(-?\d+)Y)?(?:(-?\d+)M)?(?:(-?\d+)W)?(?:(-?\d+)D)?(?:T(?:(-?\d+)H)?(?:(-?\d+)M)?(?:(-?)(\d+)(?:\.(\d{1,6}))?S)?)?$`)
+
+// parseInterval accepts ISO 8601 durations, every component may be negative.
+func parseInterval(value any) any {
+	switch v := value.(type) {
+	case string:
+		return scanInterval(v)
+	case *string:
+		if v != nil {
+			return scanInterval(*v)
+		}
+	case pgtype.Interval:
+		if v.Valid {
+			return v
+		}
+	}
+	return nil
+}
+
+func parseLiteralInterval(valueAST ast.Value) any {
+	if v, ok := valueAST.(*ast.StringValue); ok {
+		return scanInterval(v.Value)
+	}
+	return nil
+}
+
+func scanInterval(s string) any {
+	m := intervalRe.FindStringSubmatch(s)
+	// P and PT alone have no components
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return nil
+	}
+	// years, months, weeks, days, hours, minutes and seconds
+	var n [7]int64
+	for i, g := range []string{m[1], m[2], m[3], m[4], m[5], m[6], m[8]} {
+		if g == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(g, 10, 32)
+		if err != nil {
+			return nil
+		}
+		n[i] = v
+	}
+	frac, err := scanFraction(m[9])
+	if err != nil {
+		return nil
+	}
+	// the sign of seconds applies to the fraction too
+	secs := n[6]*int64(time.Second/time.Microsecond) + frac
+	if m[7] == "-" {
+		secs = -secs
+	}
+	return pgtype.Interval{
+		Months:       int32(n[0]*12 + n[1]),
+		Days:         int32(n[2]*7 + n[3]),
+		Microseconds: (n[4]*60+n[5])*int64(time.Minute/time.Microsecond) + secs,
+		Valid:        true,
+	}
+}
+
+var Interval = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "Interval",
+	Description:  "The `Interval` type represents a time span formatted as the ISO 8601 duration, e.g. P1Y2M3DT4H5M6.5S.",
+	Serialize:    serializeInterval,
+	ParseValue:   parseInterval,
+	ParseLiteral: parseLiteralInterval,
+})
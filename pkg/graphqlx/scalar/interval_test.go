@@ -0,0 +1,49 @@
+package scalar_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_Interval_Serialize(t *testing.T) {
+	for _, tc := range []struct {
+		interval pgtype.Interval
+		expected string
+	}{
+		{
+			interval: pgtype.Interval{Valid: true},
+			expected: "PT0S",
+		},
+		{
+			interval: pgtype.Interval{Months: 14, Days: 3, Microseconds: (4*3600+5*60+6)*1e6 + 500000, Valid: true},
+			expected: "P1Y2M3DT4H5M6.5S",
+		},
+		{
+			interval: pgtype.Interval{Days: -1, Microseconds: -1500000, Valid: true},
+			expected: "P-1DT-1.5S",
+		},
+	} {
+		require.Equal(t, tc.expected, scalar.Interval.Serialize(tc.interval))
+		require.Equal(t, tc.interval, scalar.Interval.ParseValue(tc.expected))
+	}
+
+	require.Nil(t, scalar.Interval.Serialize(pgtype.Interval{}))
+}
+
+func Test_Interval_ParseValue(t *testing.T) {
+	require.Equal(t, pgtype.Interval{Days: 15, Valid: true}, scalar.Interval.ParseValue("P2W1D"))
+	require.Equal(t, pgtype.Interval{Microseconds: -90 * 60 * 1e6, Valid: true}, scalar.Interval.ParseValue("PT-1H-30M"))
+	require.Nil(t, scalar.Interval.ParseValue("P"))
+	require.Nil(t, scalar.Interval.ParseValue("P1DT"))
+	require.Nil(t, scalar.Interval.ParseValue("1 day"))
+}
+
+func Test_Interval_ParseLiteral(t *testing.T) {
+	require.Equal(t, pgtype.Interval{Months: 1, Valid: true}, scalar.Interval.ParseLiteral(&ast.StringValue{Value: "P1M"}))
+	require.Nil(t, scalar.Interval.ParseLiteral(&ast.IntValue{Value: "1"}))
+}
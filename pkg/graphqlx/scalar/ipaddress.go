@@ -0,0 +1,88 @@
+package scalar
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// serializeIPAddress emits the address, the prefix length is omitted for a single host.
+func serializeIPAddress(value any) any {
+	switch v := value.(type) {
+	case netip.Prefix:
+		if !v.IsValid() {
+			return nil
+		}
+		if v.IsSingleIP() {
+			return v.Addr().String()
+		}
+		return v.String()
+	case *netip.Prefix:
+		if v != nil {
+			return serializeIPAddress(*v)
+		}
+	case netip.Addr:
+		if v.IsValid() {
+			return v.String()
+		}
+	case *netip.Addr:
+		if v != nil {
+			return serializeIPAddress(*v)
+		}
+	}
+	return nil
+}
+
+// parseIPAddress accepts IPv4 and IPv6 addresses with optional prefix lengths,
+// host bits are kept as inet does.
+func parseIPAddress(value any) any {
+	switch v := value.(type) {
+	case string:
+		return scanIPAddress(v)
+	case *string:
+		if v != nil {
+			return scanIPAddress(*v)
+		}
+	case netip.Prefix:
+		if v.IsValid() {
+			return v
+		}
+	case netip.Addr:
+		if v.IsValid() {
+			return netip.PrefixFrom(v, v.BitLen())
+		}
+	}
+	return nil
+}
+
+func parseLiteralIPAddress(valueAST ast.Value) any {
+	if v, ok := valueAST.(*ast.StringValue); ok {
+		return scanIPAddress(v.Value)
+	}
+	return nil
+}
+
+func scanIPAddress(s string) any {
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil
+		}
+		return p
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return nil
+	}
+	return netip.PrefixFrom(a, a.BitLen())
+}
+
+var IPAddress = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "IPAddress",
+	Description:  "The `IPAddress` type represents an IPv4 or IPv6 address with an optional prefix length, e.g. 192.168.0.1 or 10.0.0.0/8.",
+	Serialize:    serializeIPAddress,
+	ParseValue:   parseIPAddress,
+	ParseLiteral: parseLiteralIPAddress,
+})
@@ -0,0 +1,34 @@
+package scalar_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_IPAddress_Serialize(t *testing.T) {
+	host := netip.MustParsePrefix("192.168.0.1/32")
+
+	require.Equal(t, "192.168.0.1", scalar.IPAddress.Serialize(host))
+	require.Equal(t, "192.168.0.1", scalar.IPAddress.Serialize(&host))
+	require.Equal(t, "10.1.2.3/8", scalar.IPAddress.Serialize(netip.MustParsePrefix("10.1.2.3/8")))
+	require.Equal(t, "::1", scalar.IPAddress.Serialize(netip.MustParsePrefix("::1/128")))
+	require.Nil(t, scalar.IPAddress.Serialize(netip.Prefix{}))
+}
+
+func Test_IPAddress_ParseValue(t *testing.T) {
+	require.Equal(t, netip.MustParsePrefix("192.168.0.1/32"), scalar.IPAddress.ParseValue("192.168.0.1"))
+	require.Equal(t, netip.MustParsePrefix("10.1.2.3/8"), scalar.IPAddress.ParseValue("10.1.2.3/8"))
+	require.Equal(t, netip.MustParsePrefix("2001:db8::/32"), scalar.IPAddress.ParseValue("2001:db8::/32"))
+	require.Nil(t, scalar.IPAddress.ParseValue("256.0.0.1"))
+	require.Nil(t, scalar.IPAddress.ParseValue("10.0.0.0/33"))
+}
+
+func Test_IPAddress_ParseLiteral(t *testing.T) {
+	require.Equal(t, netip.MustParsePrefix("::1/128"), scalar.IPAddress.ParseLiteral(&ast.StringValue{Value: "::1"}))
+	require.Nil(t, scalar.IPAddress.ParseLiteral(&ast.IntValue{Value: "1"}))
+}
@@ -0,0 +1,97 @@
+package scalar
+
+import (
+	"encoding/json"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// serializeJSON emits the raw document as is, other values are marshaled by the response.
+func serializeJSON(value any) any {
+	switch v := value.(type) {
+	case json.RawMessage:
+		if v == nil {
+			return nil
+		}
+		return v
+	case *json.RawMessage:
+		if v != nil {
+			return serializeJSON(*v)
+		}
+		return nil
+	case []byte:
+		if v == nil {
+			return nil
+		}
+		return json.RawMessage(v)
+	}
+	return value
+}
+
+// parseJSON marshals the decoded value of variables to the raw document.
+func parseJSON(value any) any {
+	if raw, ok := value.(json.RawMessage); ok {
+		if !json.Valid(raw) {
+			return nil
+		}
+		return raw
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return json.RawMessage(b)
+}
+
+func parseLiteralJSON(valueAST ast.Value) any {
+	v, ok := literalValue(valueAST)
+	if !ok {
+		return nil
+	}
+	return parseJSON(v)
+}
+
+// literalValue converts object, list and scalar literals to Go values,
+// numbers are kept as written.
+func literalValue(valueAST ast.Value) (any, bool) {
+	switch v := valueAST.(type) {
+	case *ast.ObjectValue:
+		m := make(map[string]any, len(v.Fields))
+		for _, f := range v.Fields {
+			fv, ok := literalValue(f.Value)
+			if !ok {
+				return nil, false
+			}
+			m[f.Name.Value] = fv
+		}
+		return m, true
+	case *ast.ListValue:
+		list := make([]any, len(v.Values))
+		for i, item := range v.Values {
+			iv, ok := literalValue(item)
+			if !ok {
+				return nil, false
+			}
+			list[i] = iv
+		}
+		return list, true
+	case *ast.StringValue:
+		return v.Value, true
+	case *ast.IntValue:
+		return json.Number(v.Value), true
+	case *ast.FloatValue:
+		return json.Number(v.Value), true
+	case *ast.BooleanValue:
+		return v.Value, true
+	}
+	return nil, false
+}
+
+var JSON = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "JSON",
+	Description:  "The `JSON` type represents an arbitrary JSON value: an object, a list or a scalar.",
+	Serialize:    serializeJSON,
+	ParseValue:   parseJSON,
+	ParseLiteral: parseLiteralJSON,
+})
@@ -0,0 +1,67 @@
+package scalar_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_JSON_Serialize(t *testing.T) {
+	b, err := json.Marshal(scalar.JSON.Serialize(json.RawMessage(`{"a":[1,2.5,"b"]}`)))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":[1,2.5,"b"]}`, string(b))
+
+	raw := json.RawMessage(`true`)
+	b, err = json.Marshal(scalar.JSON.Serialize(&raw))
+	require.NoError(t, err)
+	require.JSONEq(t, `true`, string(b))
+
+	require.Nil(t, scalar.JSON.Serialize(json.RawMessage(nil)))
+	require.Nil(t, scalar.JSON.Serialize((*json.RawMessage)(nil)))
+}
+
+func Test_JSON_ParseValue(t *testing.T) {
+	for _, v := range []any{
+		map[string]any{"a": []any{float64(1), "b"}},
+		[]any{true, nil},
+		"foo",
+		float64(42),
+	} {
+		raw, ok := scalar.JSON.ParseValue(v).(json.RawMessage)
+		require.True(t, ok)
+
+		expected, err := json.Marshal(v)
+		require.NoError(t, err)
+		require.JSONEq(t, string(expected), string(raw))
+	}
+
+	require.Nil(t, scalar.JSON.ParseValue(json.RawMessage(`{`)))
+}
+
+func Test_JSON_ParseLiteral(t *testing.T) {
+	literal := &ast.ObjectValue{
+		Fields: []*ast.ObjectField{
+			{
+				Name: &ast.Name{Value: "tags"},
+				Value: &ast.ListValue{
+					Values: []ast.Value{
+						&ast.StringValue{Value: "new"},
+						&ast.IntValue{Value: "10"},
+						&ast.FloatValue{Value: "1.50"},
+						&ast.BooleanValue{Value: false},
+					},
+				},
+			},
+		},
+	}
+
+	raw, ok := scalar.JSON.ParseLiteral(literal).(json.RawMessage)
+	require.True(t, ok)
+	require.JSONEq(t, `{"tags":["new",10,1.50,false]}`, string(raw))
+
+	require.Nil(t, scalar.JSON.ParseLiteral(&ast.EnumValue{Value: "FOO"}))
+}
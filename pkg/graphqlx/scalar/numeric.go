@@ -1,29 +1,103 @@
 package scalar
 
 import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/language/ast"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// serializeNumeric emits the decimal string to keep the precision of the number.
 func serializeNumeric(value any) any {
-	if v, ok := value.(pgtype.Numeric); ok {
-		return v
+	switch v := value.(type) {
+	case pgtype.Numeric:
+		if !v.Valid {
+			return nil
+		}
+		s, err := v.Value()
+		if err != nil {
+			return nil
+		}
+		return s
+	case *pgtype.Numeric:
+		if v != nil {
+			return serializeNumeric(*v)
+		}
 	}
 	return nil
 }
 
+// parseNumeric accepts decimal strings and numbers of variables,
+// nil is returned for bad input and it is reported by GraphQL as an invalid value.
 func parseNumeric(value any) any {
-	panic("not yet implemented")
+	switch v := value.(type) {
+	case string:
+		return scanNumeric(v)
+	case json.Number:
+		return scanNumeric(v.String())
+	case float64:
+		return scanNumeric(strconv.FormatFloat(v, 'f', -1, 64))
+	case float32:
+		return scanNumeric(strconv.FormatFloat(float64(v), 'f', -1, 32))
+	case int:
+		return scanNumeric(strconv.Itoa(v))
+	case int32:
+		return scanNumeric(strconv.FormatInt(int64(v), 10))
+	case int64:
+		return scanNumeric(strconv.FormatInt(v, 10))
+	case pgtype.Numeric:
+		if v.Valid {
+			return v
+		}
+	case *pgtype.Numeric:
+		if v != nil {
+			return parseNumeric(*v)
+		}
+	}
+	return nil
 }
 
 func parseLiteralNumeric(valueAST ast.Value) any {
-	panic("not yet implemented")
+	switch v := valueAST.(type) {
+	case *ast.StringValue:
+		return scanNumeric(v.Value)
+	case *ast.IntValue:
+		return scanNumeric(v.Value)
+	case *ast.FloatValue:
+		return scanNumeric(v.Value)
+	}
+	return nil
+}
+
+// scanNumeric parses the decimal number, the exponent of float literals is applied exactly.
+func scanNumeric(s string) any {
+	var exp int
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return nil
+		}
+		exp, s = int(e), s[:i]
+	}
+	var n pgtype.Numeric
+	if err := n.Scan(s); err != nil || !n.Valid {
+		return nil
+	}
+	if exp != 0 {
+		if n.NaN || n.InfinityModifier != pgtype.Finite {
+			return nil
+		}
+		n.Exp += int32(exp)
+	}
+	return n
 }
 
 var Numeric = graphql.NewScalar(graphql.ScalarConfig{
 	Name:         "Numeric",
-	Description:  "The `Numeric` type represents a decimal number.",
+	Description:  "The `Numeric` type represents a decimal number, it is serialized as a string to keep the precision.",
 	Serialize:    serializeNumeric,
 	ParseValue:   parseNumeric,
 	ParseLiteral: parseLiteralNumeric,
@@ -0,0 +1,67 @@
+package scalar_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func numeric(t *testing.T, s string) pgtype.Numeric {
+	var n pgtype.Numeric
+	require.NoError(t, n.Scan(s))
+	return n
+}
+
+func Test_Numeric_Serialize(t *testing.T) {
+	require.Equal(t, "12345678901234567890.000000000000000001", scalar.Numeric.Serialize(numeric(t, "12345678901234567890.000000000000000001")))
+	require.Equal(t, "-0.05", scalar.Numeric.Serialize(numeric(t, "-0.05")))
+	require.Equal(t, "NaN", scalar.Numeric.Serialize(numeric(t, "NaN")))
+	require.Nil(t, scalar.Numeric.Serialize(pgtype.Numeric{}))
+	require.Nil(t, scalar.Numeric.Serialize((*pgtype.Numeric)(nil)))
+}
+
+func Test_Numeric_ParseValue(t *testing.T) {
+	cases := []struct {
+		value    any
+		expected any
+	}{
+		{value: "10.25", expected: numeric(t, "10.25")},
+		{value: 10.25, expected: numeric(t, "10.25")},
+		{value: 42, expected: numeric(t, "42")},
+		{value: int64(-42), expected: numeric(t, "-42")},
+		{value: "ten", expected: nil},
+		{value: true, expected: nil},
+		{value: nil, expected: nil},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, scalar.Numeric.ParseValue(c.value), "%#v", c.value)
+	}
+}
+
+func Test_Numeric_ParseLiteral(t *testing.T) {
+	cases := []struct {
+		value    ast.Value
+		expected any
+	}{
+		{value: &ast.StringValue{Value: "0.1"}, expected: numeric(t, "0.1")},
+		{value: &ast.IntValue{Value: "100"}, expected: numeric(t, "100")},
+		{value: &ast.FloatValue{Value: "1.5"}, expected: numeric(t, "1.5")},
+		{value: &ast.StringValue{Value: "1,5"}, expected: nil},
+		{value: &ast.BooleanValue{Value: true}, expected: nil},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, scalar.Numeric.ParseLiteral(c.value), "%#v", c.value)
+	}
+}
+
+func Test_Numeric_ParseLiteral_Exponent(t *testing.T) {
+	require.Equal(t, "1500", scalar.Numeric.Serialize(scalar.Numeric.ParseLiteral(&ast.FloatValue{Value: "1.5e3"})))
+	require.Equal(t, "0.00015", scalar.Numeric.Serialize(scalar.Numeric.ParseLiteral(&ast.FloatValue{Value: "1.5E-4"})))
+	require.Nil(t, scalar.Numeric.ParseLiteral(&ast.FloatValue{Value: "1.5e"}))
+}
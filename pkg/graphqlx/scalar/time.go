@@ -0,0 +1,129 @@
+package scalar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const microsecondsPerDay = int64(24 * time.Hour / time.Microsecond)
+
+// serializeTime emits the time of day as HH:MM:SS with optional fractional seconds.
+func serializeTime(value any) any {
+	switch v := value.(type) {
+	case pgtype.Time:
+		if !v.Valid {
+			return nil
+		}
+		return formatTime(v.Microseconds)
+	case *pgtype.Time:
+		if v != nil {
+			return serializeTime(*v)
+		}
+	}
+	return nil
+}
+
+func formatTime(us int64) string {
+	h := us / int64(time.Hour/time.Microsecond)
+	us -= h * int64(time.Hour/time.Microsecond)
+	m := us / int64(time.Minute/time.Microsecond)
+	us -= m * int64(time.Minute/time.Microsecond)
+	s := us / int64(time.Second/time.Microsecond)
+	us -= s * int64(time.Second/time.Microsecond)
+	return fmt.Sprintf("%02d:%02d:%02d", h, m, s) + formatFraction(us)
+}
+
+// formatFraction returns fractional seconds without trailing zeros.
+func formatFraction(us int64) string {
+	if us == 0 {
+		return ""
+	}
+	return "." + strings.TrimRight(fmt.Sprintf("%06d", us), "0")
+}
+
+// parseTime accepts HH:MM and HH:MM:SS with up to 6 fractional digits,
+// 24:00:00 is allowed as Postgres does.
+func parseTime(value any) any {
+	switch v := value.(type) {
+	case string:
+		return scanTime(v)
+	case *string:
+		if v != nil {
+			return scanTime(*v)
+		}
+	case pgtype.Time:
+		if v.Valid {
+			return v
+		}
+	}
+	return nil
+}
+
+func parseLiteralTime(valueAST ast.Value) any {
+	if v, ok := valueAST.(*ast.StringValue); ok {
+		return scanTime(v.Value)
+	}
+	return nil
+}
+
+func scanTime(s string) any {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil
+	}
+	h, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts[0]) != 2 || h < 0 || h > 24 {
+		return nil
+	}
+	m, err := strconv.Atoi(parts[1])
+	if err != nil || len(parts[1]) != 2 || m < 0 || m > 59 {
+		return nil
+	}
+	var sec, frac int64
+	if len(parts) == 3 {
+		whole, fraction, _ := strings.Cut(parts[2], ".")
+		n, err := strconv.Atoi(whole)
+		if err != nil || len(whole) != 2 || n < 0 || n > 59 {
+			return nil
+		}
+		sec = int64(n)
+		if frac, err = scanFraction(fraction); err != nil {
+			return nil
+		}
+	}
+	us := (int64(h)*3600+int64(m)*60+sec)*int64(time.Second/time.Microsecond) + frac
+	if us > microsecondsPerDay {
+		return nil
+	}
+	return pgtype.Time{Microseconds: us, Valid: true}
+}
+
+// scanFraction parses up to 6 digits of fractional seconds to microseconds.
+func scanFraction(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if len(s) > 6 {
+		return 0, strconv.ErrRange
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, strconv.ErrSyntax
+		}
+	}
+	return strconv.ParseInt(s+strings.Repeat("0", 6-len(s)), 10, 64)
+}
+
+var Time = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "Time",
+	Description:  "The `Time` type represents the time of day without a time zone, formatted as HH:MM:SS[.ffffff].",
+	Serialize:    serializeTime,
+	ParseValue:   parseTime,
+	ParseLiteral: parseLiteralTime,
+})
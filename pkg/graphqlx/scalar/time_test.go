@@ -0,0 +1,36 @@
+package scalar_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/graphqlx/scalar"
+)
+
+func Test_Time_Serialize(t *testing.T) {
+	v := pgtype.Time{Microseconds: (13*3600+5*60+9)*1e6 + 250000, Valid: true}
+
+	require.Equal(t, "13:05:09.25", scalar.Time.Serialize(v))
+	require.Equal(t, "13:05:09.25", scalar.Time.Serialize(&v))
+	require.Equal(t, "00:00:00", scalar.Time.Serialize(pgtype.Time{Valid: true}))
+	require.Nil(t, scalar.Time.Serialize(pgtype.Time{}))
+}
+
+func Test_Time_ParseValue(t *testing.T) {
+	require.Equal(t, pgtype.Time{Microseconds: (8*3600 + 30*60) * 1e6, Valid: true}, scalar.Time.ParseValue("08:30"))
+	require.Equal(t, pgtype.Time{Microseconds: 59*1e6 + 123456, Valid: true}, scalar.Time.ParseValue("00:00:59.123456"))
+	require.Equal(t, pgtype.Time{Microseconds: 24 * 3600 * 1e6, Valid: true}, scalar.Time.ParseValue("24:00:00"))
+	require.Nil(t, scalar.Time.ParseValue("24:00:01"))
+	require.Nil(t, scalar.Time.ParseValue("8:30"))
+	require.Nil(t, scalar.Time.ParseValue("08:60"))
+	require.Nil(t, scalar.Time.ParseValue("08:30:00.1234567"))
+	require.Nil(t, scalar.Time.ParseValue("-1:30"))
+}
+
+func Test_Time_ParseLiteral(t *testing.T) {
+	require.Equal(t, pgtype.Time{Microseconds: 3600 * 1e6, Valid: true}, scalar.Time.ParseLiteral(&ast.StringValue{Value: "01:00:00"}))
+	require.Nil(t, scalar.Time.ParseLiteral(&ast.IntValue{Value: "3600"}))
+}
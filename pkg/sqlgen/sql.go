@@ -2,30 +2,85 @@ package sqlgen
 
 import (
 	"bytes"
+	"slices"
+	"sort"
 	"strconv"
+
+	"github.com/pkg/errors"
 )
 
-func Update(table string, set map[string]any) (string, []any) {
+// Insert renders the insert of supplied columns only,
+// so Postgres fills omitted columns by their defaults.
+func Insert(t *Table, set map[string]any) (string, []any) {
+	args := make([]any, 0, len(set))
+
+	b := bytes.NewBufferString("insert into ")
+	b.WriteString(t.Ident())
+	values := new(bytes.Buffer)
+	for _, k := range sortedKeys(set) {
+		col, ok := t.Column(k)
+		if !ok {
+			continue
+		}
+		// sql
+		if len(args) > 0 {
+			b.WriteByte(',')
+			values.WriteByte(',')
+		} else {
+			b.WriteByte('(')
+		}
+		b.WriteString(col)
+		values.WriteByte('$')
+		values.WriteString(strconv.Itoa(len(args) + 1))
+		// args
+		args = append(args, set[k])
+	}
+
+	if len(args) == 0 {
+		b.WriteString(" default values")
+	} else {
+		b.WriteString(")values(")
+		b.Write(values.Bytes())
+		b.WriteByte(')')
+	}
+
+	return b.String(), args
+}
+
+// Update renders the update of supplied columns,
+// the error is returned when no column of the table is supplied.
+func Update(t *Table, set map[string]any) (string, []any, error) {
+	if !slices.ContainsFunc(sortedKeys(set), func(k string) bool {
+		_, ok := t.Column(k)
+		return ok
+	}) {
+		return "", nil, errors.Errorf("update %s: no columns to set", t.Ident())
+	}
+
 	args := make([]any, 0, len(set))
 
 	b := bytes.NewBufferString("update ")
-	b.WriteString(table)
+	b.WriteString(t.Ident())
 	b.WriteString(" set ")
-	for k, v := range set {
+	for _, k := range sortedKeys(set) {
+		col, ok := t.Column(k)
+		if !ok {
+			continue
+		}
 		// sql
 		if len(args) > 0 {
 			b.WriteByte(',')
 		}
-		b.WriteString(k)
+		b.WriteString(col)
 		b.WriteString("=$")
 		b.WriteString(strconv.Itoa(len(args) + 1))
 		// args
-		args = append(args, v)
+		args = append(args, set[k])
 	}
 
 	b.WriteString(" where 1=1")
 
-	return b.String(), args
+	return b.String(), args, nil
 }
 
 func Returning(sql string, args []any, columns string) (string, []any) {
@@ -34,3 +89,20 @@ func Returning(sql string, args []any, columns string) (string, []any) {
 	b.WriteString(columns)
 	return b.String(), args
 }
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GroupBy groups rows of the query by columns.
+func GroupBy(sql string, args []any, columns string) (string, []any) {
+	b := bytes.NewBufferString(sql)
+	b.WriteString(" group by ")
+	b.WriteString(columns)
+	return b.String(), args
+}
@@ -0,0 +1,66 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/regeda/turboql/pkg/sqlgen"
+)
+
+func Test_Ident(t *testing.T) {
+	require.Equal(t, `"public"."order"`, sqlgen.Ident("public", "order"))
+	require.Equal(t, `"Mixed Case"`, sqlgen.Ident("Mixed Case"))
+	require.Equal(t, `"a""b"`, sqlgen.Ident(`a"b`))
+}
+
+func Test_Update(t *testing.T) {
+	table := sqlgen.NewTable("public", "user", map[string]string{
+		"name":       "name",
+		"Created_At": "Created At",
+	})
+
+	sql, args, err := sqlgen.Update(table, map[string]any{
+		"name":                "foo",
+		"Created_At":          "2024-01-01",
+		"name=null where 1=1": "bar",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, `update "public"."user" set "Created At"=$1,"name"=$2 where 1=1`, sql)
+	require.Equal(t, []any{"2024-01-01", "foo"}, args)
+
+	_, _, err = sqlgen.Update(table, map[string]any{})
+	require.EqualError(t, err, `update "public"."user": no columns to set`)
+
+	_, _, err = sqlgen.Update(table, map[string]any{"unknown": 1})
+	require.EqualError(t, err, `update "public"."user": no columns to set`)
+}
+
+func Test_Insert(t *testing.T) {
+	table := sqlgen.NewTable("public", "order", map[string]string{
+		"id":         "id",
+		"name":       "name",
+		"Created_At": "Created At",
+	})
+
+	sql, args := sqlgen.Insert(table, map[string]any{
+		"name":       "foo",
+		"Created_At": nil,
+	})
+
+	require.Equal(t, `insert into "public"."order"("Created At","name")values($1,$2)`, sql)
+	require.Equal(t, []any{nil, "foo"}, args)
+
+	sql, args = sqlgen.Insert(table, map[string]any{})
+
+	require.Equal(t, `insert into "public"."order" default values`, sql)
+	require.Empty(t, args)
+}
+
+func Test_GroupBy(t *testing.T) {
+	sql, args := sqlgen.GroupBy(`select "order_id",count(*) from "order_line" where "price">$1`, []any{5}, `"order_id"`)
+
+	require.Equal(t, `select "order_id",count(*) from "order_line" where "price">$1 group by "order_id"`, sql)
+	require.Equal(t, []any{5}, args)
+}
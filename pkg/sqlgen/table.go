@@ -0,0 +1,65 @@
+package sqlgen
+
+import "github.com/jackc/pgx/v5"
+
+// Ident quotes parts of the identifier and joins them by dots,
+// e.g. Ident("public", "order") returns "public"."order".
+func Ident(parts ...string) string {
+	return pgx.Identifier(parts).Sanitize()
+}
+
+// Table is the introspected relation, the query text is rendered only by its known columns,
+// so names coming from arguments never reach SQL as is.
+type Table struct {
+	ident     string
+	columns   map[string]string
+	relations map[string]Relation
+}
+
+// Relation joins rows of the related table, Columns[i] of the related table equals Parent[i] of the table.
+type Relation struct {
+	Table   *Table
+	Columns []string
+	Parent  []string
+}
+
+// NewTable creates the table of the schema, columns map field names to column names.
+func NewTable(schema, name string, columns map[string]string) *Table {
+	t := &Table{
+		ident:     Ident(schema, name),
+		columns:   make(map[string]string, len(columns)),
+		relations: make(map[string]Relation),
+	}
+	for field, column := range columns {
+		t.columns[field] = Ident(column)
+	}
+	return t
+}
+
+// Ident returns the quoted name of the table qualified by the schema.
+func (t *Table) Ident() string {
+	return t.ident
+}
+
+// Column returns the quoted column of the field.
+func (t *Table) Column(field string) (string, bool) {
+	c, ok := t.columns[field]
+	return c, ok
+}
+
+// AddRelation adds the related table of the field,
+// columns of the related table are joined to parent columns of the table by position.
+func (t *Table) AddRelation(field string, related *Table, columns, parent []string) {
+	r := Relation{Table: related}
+	for i := range columns {
+		r.Columns = append(r.Columns, Ident(columns[i]))
+		r.Parent = append(r.Parent, Ident(parent[i]))
+	}
+	t.relations[field] = r
+}
+
+// Relation returns the related table of the field.
+func (t *Table) Relation(field string) (Relation, bool) {
+	r, ok := t.relations[field]
+	return r, ok
+}